@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"log"
 	"reflect"
 
 	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
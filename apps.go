@@ -0,0 +1,51 @@
+package steam
+
+import (
+	"context"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"google.golang.org/protobuf/proto"
+)
+
+// Provides access to the tickets that prove the ownership of apps. The appticket package parses them.
+type Apps struct {
+	client *Client
+}
+
+// Requests an encrypted app ticket, which contains the user data and the app ownership ticket,
+// encrypted with the app's secret key. Send it to your backend, which decrypts it with appticket.DecryptEncryptedTicket.
+// Returns the serialized EncryptedAppTicket message.
+func (a *Apps) RequestEncryptedAppTicket(ctx context.Context, appId uint32, userData []byte) ([]byte, error) {
+	packet, err := a.client.Call(ctx, protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientRequestEncryptedAppTicket, &protobuf.CMsgClientRequestEncryptedAppTicket{
+		AppId:    proto.Uint32(appId),
+		Userdata: userData,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	body := new(protobuf.CMsgClientRequestEncryptedAppTicketResponse)
+	packet.ReadProtoMsg(body)
+	if result := steamlang.EResult(body.GetEresult()); result != steamlang.EResult_OK {
+		return nil, &EResultError{Op: "encrypted app ticket", Result: result}
+	}
+	return proto.Marshal(body.GetEncryptedAppTicket())
+}
+
+// Requests the app ownership ticket of the logged on user, which is signed by Steam.
+// It can be parsed and verified with appticket.ParseOwnershipTicket.
+func (a *Apps) GetAppOwnershipTicket(ctx context.Context, appId uint32) ([]byte, error) {
+	packet, err := a.client.Call(ctx, protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientGetAppOwnershipTicket, &protobuf.CMsgClientGetAppOwnershipTicket{
+		AppId: proto.Uint32(appId),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	body := new(protobuf.CMsgClientGetAppOwnershipTicketResponse)
+	packet.ReadProtoMsg(body)
+	if result := steamlang.EResult(body.GetEresult()); result != steamlang.EResult_OK {
+		return nil, &EResultError{Op: "app ownership ticket", Result: result}
+	}
+	return body.GetTicket(), nil
+}
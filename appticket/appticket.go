@@ -0,0 +1,240 @@
+/*
+Package appticket parses the tickets that prove that a Steam user owns an app, so that a backend
+can check them without the Steamworks SDK.
+
+App ownership tickets come from steam.Apps.GetAppOwnershipTicket or at the end of an auth session
+ticket from steam.Auth.GetAuthSessionTicket. They are signed by Steam:
+
+	ticket, err := appticket.ParseOwnershipTicket(data)
+	if err != nil {
+		return err
+	}
+	if err = ticket.Verify(nil); err != nil || ticket.Expired() {
+		return errors.New("invalid ticket")
+	}
+
+Encrypted app tickets come from steam.Apps.RequestEncryptedAppTicket and are decrypted with the
+app's encrypted ticket key from the Steamworks partner site:
+
+	ticket, err := appticket.DecryptEncryptedTicket(data, key)
+*/
+package appticket
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/cryptoutil"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+)
+
+// Returned if a ticket can't be parsed.
+var ErrInvalidTicket = errors.New("appticket: invalid ticket")
+
+// Returned by Verify if the ticket has no signature.
+var ErrNoSignature = errors.New("appticket: ticket is not signed")
+
+// The public key of Steam that signs app ownership tickets, DER-encoded.
+// It is the same as the public universe key that is used for channel encryption.
+const steamPublicKey = "MIGdMA0GCSqGSIb3DQEBAQUAA4GLADCBhwKBgQDf7BrWLBBmLBc1OhSwfFkRf53T2Ct64+AVzRkeRuh7h3SiGEYxqQMUeYKO6UWiSRKpI2hzic9pobFhRr3Bvr/WARvYgdTckPv+T1JzZsuVcNfFjrocejN1oWI0Rrtgt4Bo+hOneoo3S57G9F1fOpn5nsQ66WOiu4gZKODnFMBCiQIBEQ=="
+
+// The size of the RSA signature at the end of a signed ownership ticket.
+const signatureSize = 128
+
+// Returns the public key of Steam that signs app ownership tickets.
+func SteamPublicKey() *rsa.PublicKey {
+	der, _ := base64.StdEncoding.DecodeString(steamPublicKey)
+	key, err := cryptoutil.ParseASN1RSAPublicKey(der)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// The session header of an auth session ticket, which the client adds in front of the ownership ticket.
+type SessionHeader struct {
+	// The game connect token the ticket was built from.
+	GcToken uint64
+	// The Steam ID the game connect token was issued to.
+	TokenSteamId steamid.SteamId
+	// The time the game connect token was issued.
+	TokenGenerated time.Time
+	// The time the client has been connected to Steam in milliseconds.
+	ClientConnectionTime uint32
+	// The number of servers the client has connected to.
+	ClientConnectionCount uint32
+	// The part of the ticket that a game server sends to Steam to validate it.
+	AuthTicket []byte
+}
+
+// An app ownership ticket.
+type OwnershipTicket struct {
+	// Only set if the ticket was an auth session ticket.
+	Session *SessionHeader
+
+	Version    uint32
+	SteamId    steamid.SteamId
+	AppId      uint32
+	ExternalIp net.IP
+	InternalIp net.IP
+	Flags      uint32
+	Generated  time.Time
+	Expires    time.Time
+	// The IDs of the packages that grant the app.
+	Licenses []uint32
+	Dlc      []Dlc
+	// The signature of Steam, or nil if the ticket isn't signed.
+	Signature []byte
+
+	// the signed part of the ticket
+	signed []byte
+}
+
+// A DLC of the app that the user owns.
+type Dlc struct {
+	AppId    uint32
+	Licenses []uint32
+}
+
+// Returns true if the ticket has expired.
+func (t *OwnershipTicket) Expired() bool {
+	return time.Now().After(t.Expires)
+}
+
+// Verifies the signature of the ticket with the given key. If key is nil, the public key of Steam is used.
+// Returns ErrNoSignature if the ticket isn't signed, which is the case for the ownership tickets in
+// encrypted app tickets.
+func (t *OwnershipTicket) Verify(key *rsa.PublicKey) error {
+	if t.Signature == nil {
+		return ErrNoSignature
+	}
+	if key == nil {
+		key = SteamPublicKey()
+	}
+	hash := sha1.Sum(t.signed)
+	return rsa.VerifyPKCS1v15(key, crypto.SHA1, hash[:], t.Signature)
+}
+
+// Parses an app ownership ticket. Auth session tickets, which have a session header in front of
+// the ownership ticket, are accepted as well.
+func ParseOwnershipTicket(data []byte) (*OwnershipTicket, error) {
+	if len(data) < 4 {
+		return nil, ErrInvalidTicket
+	}
+	r := &reader{data: data}
+	var session *SessionHeader
+	if r.uint32() == 20 {
+		session = &SessionHeader{
+			GcToken:        r.uint64(),
+			TokenSteamId:   steamid.SteamId(r.uint64()),
+			TokenGenerated: r.time(),
+		}
+		if r.uint32() != 24 {
+			return nil, ErrInvalidTicket
+		}
+		r.skip(8) // unknown
+		r.skip(8) // public and private IP
+		session.ClientConnectionTime = r.uint32()
+		session.ClientConnectionCount = r.uint32()
+		if r.err != nil {
+			return nil, ErrInvalidTicket
+		}
+		session.AuthTicket = data[:r.offset]
+		if int(r.uint32()) != len(data)-r.offset {
+			return nil, ErrInvalidTicket
+		}
+	} else {
+		r.offset -= 4
+	}
+
+	start := r.offset
+	length := int(r.uint32())
+	if end := start + length; end != len(data) && end+signatureSize != len(data) {
+		return nil, ErrInvalidTicket
+	}
+	t := &OwnershipTicket{
+		Session:    session,
+		Version:    r.uint32(),
+		SteamId:    steamid.SteamId(r.uint64()),
+		AppId:      r.uint32(),
+		ExternalIp: r.ip(),
+		InternalIp: r.ip(),
+		Flags:      r.uint32(),
+		Generated:  r.time(),
+		Expires:    r.time(),
+		Licenses:   r.licenses(),
+	}
+	for i, count := 0, int(r.uint16()); i < count && r.err == nil; i++ {
+		t.Dlc = append(t.Dlc, Dlc{
+			AppId:    r.uint32(),
+			Licenses: r.licenses(),
+		})
+	}
+	r.skip(2) // reserved
+	if r.err != nil || r.offset != start+length {
+		return nil, ErrInvalidTicket
+	}
+	t.signed = data[start:r.offset]
+	if len(data)-r.offset == signatureSize {
+		t.Signature = data[r.offset:]
+	}
+	return t, nil
+}
+
+// Reads little-endian values. After the first read past the end, err is set and all values are zero.
+type reader struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+func (r *reader) next(n int) []byte {
+	if r.err != nil || len(r.data)-r.offset < n {
+		r.err = io.ErrUnexpectedEOF
+		return make([]byte, n)
+	}
+	b := r.data[r.offset : r.offset+n]
+	r.offset += n
+	return b
+}
+
+func (r *reader) skip(n int) {
+	r.next(n)
+}
+
+func (r *reader) uint16() uint16 {
+	return binary.LittleEndian.Uint16(r.next(2))
+}
+
+func (r *reader) uint32() uint32 {
+	return binary.LittleEndian.Uint32(r.next(4))
+}
+
+func (r *reader) uint64() uint64 {
+	return binary.LittleEndian.Uint64(r.next(8))
+}
+
+func (r *reader) time() time.Time {
+	return time.Unix(int64(r.uint32()), 0)
+}
+
+func (r *reader) ip() net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, r.uint32())
+	return ip
+}
+
+func (r *reader) licenses() []uint32 {
+	var licenses []uint32
+	for i, count := 0, int(r.uint16()); i < count && r.err == nil; i++ {
+		licenses = append(licenses, r.uint32())
+	}
+	return licenses
+}
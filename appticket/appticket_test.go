@@ -0,0 +1,151 @@
+package appticket
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/cryptoutil"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// Returns an ownership ticket of 76561197960265729 for app 440 with one license and one DLC.
+func buildOwnershipTicket() []byte {
+	body := new(bytes.Buffer)
+	w := func(values ...interface{}) {
+		for _, v := range values {
+			binary.Write(body, binary.LittleEndian, v)
+		}
+	}
+	w(uint32(4), uint64(76561197960265729), uint32(440))
+	w(uint32(0x7F000001), uint32(0xC0A80001), uint32(0))
+	w(uint32(time.Now().Unix()), uint32(time.Now().Add(time.Hour).Unix()))
+	w(uint16(1), uint32(1001))
+	w(uint16(1), uint32(441), uint16(1), uint32(1002))
+	w(uint16(0))
+
+	ticket := new(bytes.Buffer)
+	binary.Write(ticket, binary.LittleEndian, uint32(4+body.Len()))
+	ticket.Write(body.Bytes())
+	return ticket.Bytes()
+}
+
+func TestParseOwnershipTicket(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := buildOwnershipTicket()
+	hash := sha1.Sum(data)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, signature...)
+
+	ticket, err := ParseOwnershipTicket(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticket.SteamId != 76561197960265729 || ticket.AppId != 440 || ticket.ExternalIp.String() != "127.0.0.1" || ticket.Expired() {
+		t.Fatalf("Unexpected ticket %+v", ticket)
+	}
+	if len(ticket.Licenses) != 1 || ticket.Licenses[0] != 1001 || len(ticket.Dlc) != 1 || ticket.Dlc[0].AppId != 441 || ticket.Dlc[0].Licenses[0] != 1002 {
+		t.Fatalf("Unexpected licenses %v and DLC %v", ticket.Licenses, ticket.Dlc)
+	}
+	if err = ticket.Verify(&key.PublicKey); err != nil {
+		t.Fatal(err)
+	}
+	if err = ticket.Verify(nil); err == nil {
+		t.Fatal("Expected the ticket not to be signed by Steam")
+	}
+
+	data[10] ^= 1
+	if ticket, err = ParseOwnershipTicket(data); err != nil || ticket.Verify(&key.PublicKey) == nil {
+		t.Fatalf("Expected a changed ticket to fail verification, got %v", err)
+	}
+	if _, err = ParseOwnershipTicket(data[:20]); err != ErrInvalidTicket {
+		t.Fatalf("Expected ErrInvalidTicket for a truncated ticket, got %v", err)
+	}
+}
+
+// Encrypts the user data and the ownership ticket like Steam, followed by a salt and their salted SHA-1 hash.
+func encryptTicket(block cipher.Block, userData, ownership []byte) []byte {
+	plain := new(bytes.Buffer)
+	plain.Write(userData)
+	plain.Write(ownership)
+	salt := make([]byte, 8)
+	rand.Read(salt)
+	h := sha1.New()
+	h.Write(plain.Bytes())
+	h.Write(salt)
+	plain.Write(salt)
+	plain.Write(h.Sum(nil))
+	return cryptoutil.SymmetricEncrypt(block, plain.Bytes())
+}
+
+func marshalEncryptedTicket(t *testing.T, userDataSize, ownershipSize int, encrypted []byte) []byte {
+	data, err := proto.Marshal(&protobuf.EncryptedAppTicket{
+		TicketVersionNo:               proto.Uint32(2),
+		CrcEncryptedticket:            proto.Uint32(crc32.ChecksumIEEE(encrypted)),
+		CbEncrypteduserdata:           proto.Uint32(uint32(userDataSize)),
+		CbEncryptedAppownershipticket: proto.Uint32(uint32(ownershipSize)),
+		EncryptedTicket:               encrypted,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestDecryptEncryptedTicket(t *testing.T) {
+	key := make([]byte, 32)
+	rand.Read(key)
+	userData := []byte("userdata")
+	ownership := buildOwnershipTicket()
+	block, _ := aes.NewCipher(key)
+	data := marshalEncryptedTicket(t, len(userData), len(ownership), encryptTicket(block, userData, ownership))
+
+	ticket, err := DecryptEncryptedTicket(data, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ticket.UserData, userData) || ticket.Ownership.AppId != 440 || ticket.Ownership.Signature != nil {
+		t.Fatalf("Unexpected ticket %+v", ticket)
+	}
+
+	rand.Read(key)
+	if _, err = DecryptEncryptedTicket(data, key); err != ErrWrongKey {
+		t.Fatalf("Expected ErrWrongKey, got %v", err)
+	}
+}
+
+func TestDecryptTamperedTicket(t *testing.T) {
+	key := make([]byte, 32)
+	rand.Read(key)
+	userData := []byte("userdata")
+	ownership := buildOwnershipTicket()
+	block, _ := aes.NewCipher(key)
+	encrypted := encryptTicket(block, userData, ownership)
+
+	// Flipping a bit of the IV flips the same bit of the first plaintext block, which starts with
+	// the user data, without breaking the padding or the ownership ticket.
+	iv := make([]byte, aes.BlockSize)
+	block.Decrypt(iv, encrypted[:aes.BlockSize])
+	iv[0] ^= 1
+	block.Encrypt(encrypted[:aes.BlockSize], iv)
+
+	data := marshalEncryptedTicket(t, len(userData), len(ownership), encrypted)
+	if _, err := DecryptEncryptedTicket(data, key); err != ErrHashMismatch {
+		t.Fatalf("Expected ErrHashMismatch, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package appticket
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/sha1"
+	"errors"
+	"hash/crc32"
+
+	"github.com/Philipp15b/go-steam/v3/cryptoutil"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// Returned by DecryptEncryptedTicket if the ticket wasn't encrypted with the key.
+var ErrWrongKey = errors.New("appticket: ticket can't be decrypted with the key")
+
+// Returned by DecryptEncryptedTicket if the decrypted ticket doesn't match its hash, because it has been tampered with.
+var ErrHashMismatch = errors.New("appticket: hash of the decrypted ticket doesn't match")
+
+// The length of the salt that is hashed with the user data and the ownership ticket.
+const encryptedTicketSaltSize = 8
+
+// A decrypted encrypted app ticket.
+type EncryptedTicket struct {
+	// The user data that was passed to RequestEncryptedAppTicket.
+	UserData []byte
+	// The ownership ticket of the user. It has no signature, since the encryption proves that it comes from Steam.
+	Ownership *OwnershipTicket
+}
+
+// Decrypts a serialized EncryptedAppTicket message, as returned by steam.Apps.RequestEncryptedAppTicket,
+// with the app's encrypted ticket key. The key is the hex-decoded key from the Steamworks partner site.
+// The salted hash in the ticket is verified, so ErrHashMismatch is returned if it has been tampered with.
+func DecryptEncryptedTicket(data, key []byte) (*EncryptedTicket, error) {
+	ticket := new(protobuf.EncryptedAppTicket)
+	if err := proto.Unmarshal(data, ticket); err != nil {
+		return nil, err
+	}
+	encrypted := ticket.GetEncryptedTicket()
+	if crc32.ChecksumIEEE(encrypted) != ticket.GetCrcEncryptedticket() {
+		return nil, ErrInvalidTicket
+	}
+	if len(encrypted) < 2*aes.BlockSize || len(encrypted)%aes.BlockSize != 0 {
+		return nil, ErrInvalidTicket
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	decrypted := cryptoutil.SymmetricDecrypt(block, append([]byte(nil), encrypted...))
+
+	// the user data and the ownership ticket, followed by a salt and the SHA-1 hash of both with the salt
+	userDataSize := int(ticket.GetCbEncrypteduserdata())
+	r := &reader{data: decrypted, offset: userDataSize}
+	ownershipSize := int(r.uint32())
+	if decrypted == nil || r.err != nil || len(decrypted) < userDataSize+ownershipSize {
+		return nil, ErrWrongKey
+	}
+	ownership, err := ParseOwnershipTicket(decrypted[userDataSize : userDataSize+ownershipSize])
+	if err != nil {
+		return nil, ErrWrongKey
+	}
+	hashed := decrypted[:userDataSize+ownershipSize]
+	rest := decrypted[len(hashed):]
+	if len(rest) < encryptedTicketSaltSize+sha1.Size {
+		return nil, ErrInvalidTicket
+	}
+	h := sha1.New()
+	h.Write(hashed)
+	h.Write(rest[:encryptedTicketSaltSize])
+	if !bytes.Equal(h.Sum(nil), rest[encryptedTicketSaltSize:encryptedTicketSaltSize+sha1.Size]) {
+		return nil, ErrHashMismatch
+	}
+	return &EncryptedTicket{
+		UserData:  decrypted[:userDataSize],
+		Ownership: ownership,
+	}, nil
+}
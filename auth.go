@@ -1,7 +1,9 @@
 package steam
 
 import (
+	"context"
 	"crypto/sha1"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -13,8 +15,25 @@ import (
 )
 
 type Auth struct {
-	client  *Client
-	details *LogOnDetails
+	client *Client
+
+	mutex         sync.Mutex // guarding details, anonymous, guardAttempts, guardRetry and transientResult
+	details       *LogOnDetails
+	anonymous     *anonymousLogOn
+	guardAttempts int
+	guardRetry    bool
+	// the result of the last logon that failed on Steam's side, which only ends with a disconnect
+	transientResult steamlang.EResult
+
+	tickets authTickets
+}
+
+// The details of a logon without credentials.
+type anonymousLogOn struct {
+	// EAccountType_AnonUser, EAccountType_GameServer or EAccountType_AnonGameServer
+	accountType steamlang.EAccountType
+	appId       uint32
+	token       string
 }
 
 type SentryHash []byte
@@ -33,9 +52,18 @@ type LogOnDetails struct {
 	SentryFileHash SentryHash
 	LoginKey       string
 
+	// A refresh token from Auth.LogOnWithCredentials, which is used instead of the password.
+	// It is sent as the access_token of the logon.
+	RefreshToken string
+
 	// true if you want to get a login key which can be used in lieu of
 	// a password for subsequent logins. false or omitted otherwise.
 	ShouldRememberPassword bool
+
+	// If set, it is called for a Steam Guard code when Steam rejects the logon because one is
+	// needed or the given one was wrong. Auth then reconnects to the same server and logs on
+	// again with the code. See SteamGuardRequiredEvent.
+	GuardCodeProvider GuardCodeProvider
 }
 
 // Log on with the given details. You must always specify username and
@@ -49,17 +77,47 @@ type LogOnDetails struct {
 //
 // After the event EMsg_ClientNewLoginKey is received you can use the LoginKey
 // to login instead of using the password.
-func (a *Auth) LogOn(details *LogOnDetails) {
-	if details.Username == "" {
+//
+// Steam has deprecated logging on with passwords and login keys in favour of refresh tokens,
+// which are obtained with LogOnWithCredentials. With a RefreshToken, no password is needed.
+//
+// If the client has a CredentialStore, the sentry hash and, if no password is given, the refresh
+// token or login key are taken from it unless they are set in the details. A refresh token that
+// Steam rejects with EResult_AccessDenied or EResult_Expired is removed from the store.
+// Use HasCredentials to check whether a username is enough.
+//
+// While Auth is retrying a logon with a code from the GuardCodeProvider, calls are ignored, so
+// logging on in response to every ConnectedEvent doesn't interfere with the retry.
+func (a *Auth) LogOn(logOnDetails *LogOnDetails) {
+	a.mutex.Lock()
+	retrying := a.guardRetry
+	if !retrying {
+		a.guardAttempts = 0
+	}
+	a.mutex.Unlock()
+	if retrying {
+		a.client.log(LogAuth).Debug("Ignoring logon while retrying with a Steam Guard code")
+		return
+	}
+	a.logOn(logOnDetails)
+}
+
+func (a *Auth) logOn(logOnDetails *LogOnDetails) {
+	if logOnDetails.Username == "" {
 		panic("Username must be set!")
 	}
-	if details.Password == "" && details.LoginKey == "" {
-		panic("Password or LoginKey must be set!")
+	details, machineId := a.withStoredCredentials(logOnDetails)
+	if details.Password == "" && details.LoginKey == "" && details.RefreshToken == "" {
+		panic("Password, LoginKey or RefreshToken must be set!")
 	}
 
 	logon := new(protobuf.CMsgClientLogon)
 	logon.AccountName = &details.Username
-	logon.Password = &details.Password
+	if details.RefreshToken != "" {
+		logon.AccessToken = proto.String(details.RefreshToken)
+	} else {
+		logon.Password = &details.Password
+	}
 	if details.AuthCode != "" {
 		logon.AuthCode = proto.String(details.AuthCode)
 	}
@@ -75,12 +133,165 @@ func (a *Auth) LogOn(details *LogOnDetails) {
 	if details.ShouldRememberPassword {
 		logon.ShouldRememberPassword = proto.Bool(details.ShouldRememberPassword)
 	}
+	logon.MachineId = machineId
+
+	a.mutex.Lock()
+	a.details = details
+	a.anonymous = nil
+	a.mutex.Unlock()
 
-	atomic.StoreUint64(&a.client.steamId, uint64(steamid.NewIdAdv(0, 1, int32(steamlang.EUniverse_Public), int32(steamlang.EAccountType_Individual))))
+	atomic.StoreUint64(&a.client.steamId, uint64(steamid.NewIdAdv(0, 1, int32(a.client.universe()), int32(steamlang.EAccountType_Individual))))
 
+	a.client.log(LogAuth).Info("Logging on", "username", details.Username, "loginKey", details.LoginKey != "", "refreshToken", details.RefreshToken != "",
+		"authCode", details.AuthCode != "" || details.TwoFactorCode != "")
 	a.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogon, logon))
 }
 
+// Logs on to an anonymous user account, which needs no credentials. Anonymous users can query
+// public information like PICS product info and download the depots of free apps.
+// A LoggedOnEvent or LogOnFailedEvent is emitted as usual.
+func (a *Auth) LogOnAnonymous() {
+	a.logOnAnonymous(&anonymousLogOn{accountType: steamlang.EAccountType_AnonUser})
+}
+
+// Logs on as a persistent game server of the app, with a game server login token from
+// https://steamcommunity.com/dev/managegameservers. Steam assigns the server its Steam ID,
+// which is the same for every logon with the token.
+func (a *Auth) LogOnGameServer(appId uint32, token string) {
+	a.logOnAnonymous(&anonymousLogOn{accountType: steamlang.EAccountType_GameServer, appId: appId, token: token})
+}
+
+// Logs on as an anonymous game server of the app, which gets a new Steam ID for every logon.
+func (a *Auth) LogOnAnonymousGameServer(appId uint32) {
+	a.logOnAnonymous(&anonymousLogOn{accountType: steamlang.EAccountType_AnonGameServer, appId: appId})
+}
+
+func (a *Auth) logOnAnonymous(anon *anonymousLogOn) {
+	eMsg := steamlang.EMsg_ClientLogon
+	logon := new(protobuf.CMsgClientLogon)
+	logon.ProtocolVersion = proto.Uint32(steamlang.MsgClientLogon_CurrentProtocol)
+	switch anon.accountType {
+	case steamlang.EAccountType_AnonUser:
+		logon.ClientLanguage = proto.String("english")
+	case steamlang.EAccountType_GameServer:
+		eMsg = steamlang.EMsg_ClientLogonGameServer
+		logon.GameServerToken = proto.String(anon.token)
+		logon.GameServerAppId = proto.Int32(int32(anon.appId))
+	case steamlang.EAccountType_AnonGameServer:
+		logon.GameServerAppId = proto.Int32(int32(anon.appId))
+	}
+
+	a.mutex.Lock()
+	a.details = nil
+	a.anonymous = anon
+	a.mutex.Unlock()
+
+	atomic.StoreUint64(&a.client.steamId, uint64(steamid.NewIdAdv(0, 0, int32(a.client.universe()), int32(anon.accountType))))
+
+	a.client.log(LogAuth).Info("Logging on anonymously", "accountType", anon.accountType, "appId", anon.appId)
+	a.client.Write(protocol.NewClientMsgProtobuf(eMsg, logon))
+}
+
+// Returns a copy of the details with the secrets that weren't given filled in from the
+// CredentialStore, and the account's machine ID. Without a store, the machine ID is nil.
+func (a *Auth) withStoredCredentials(details *LogOnDetails) (*LogOnDetails, []byte) {
+	filled := *details
+	if a.client.CredentialStore == nil {
+		return &filled, nil
+	}
+	creds := a.client.loadCredentials(details.Username)
+	if creds == nil {
+		creds = new(Credentials)
+	}
+	if filled.SentryFileHash == nil {
+		filled.SentryFileHash = creds.SentryHash
+	}
+	if filled.Password == "" && filled.LoginKey == "" && filled.RefreshToken == "" {
+		filled.RefreshToken = creds.RefreshToken
+		if filled.RefreshToken == "" {
+			filled.LoginKey = creds.LoginKey
+		}
+	}
+	machineId := creds.MachineId
+	if machineId == nil {
+		machineId = generateMachineId()
+		a.client.updateCredentials(details.Username, func(creds *Credentials) {
+			creds.MachineId = machineId
+		})
+	}
+	return &filled, machineId
+}
+
+// Returns true if the details or the stored credentials of the account contain a password,
+// login key or refresh token. LogOn panics if none of them is there.
+func (a *Auth) HasCredentials(details *LogOnDetails) bool {
+	if details.Password != "" || details.LoginKey != "" || details.RefreshToken != "" {
+		return true
+	}
+	creds := a.client.loadCredentials(details.Username)
+	return creds != nil && (creds.RefreshToken != "" || creds.LoginKey != "")
+}
+
+// Logs on like LogOn, but blocks until Steam has answered. If the logon is rejected, an *EResultError
+// carrying the result is returned. If the connection is lost in the meantime, the error that caused the
+// disconnect or ErrNotConnected is returned. The usual events are still emitted.
+// Retries with a code from the GuardCodeProvider are waited for as well.
+func (a *Auth) LogOnContext(ctx context.Context, details *LogOnDetails) error {
+	return a.waitForLogOn(ctx, func() {
+		a.LogOn(details)
+	})
+}
+
+// Calls logOn and waits for the result like LogOnContext.
+func (a *Auth) waitForLogOn(ctx context.Context, logOn func()) error {
+	if !a.client.Connected() {
+		return ErrNotConnected
+	}
+
+	sub := a.client.Subscribe(&SubscribeOptions{Buffer: 32})
+	defer sub.Unsubscribe()
+	a.mutex.Lock()
+	a.transientResult = steamlang.EResult_Invalid
+	a.mutex.Unlock()
+	logOn()
+
+	retrying := false
+	var lastErr error
+	for {
+		select {
+		case event := <-sub.Events():
+			switch e := event.(type) {
+			case *LoggedOnEvent:
+				return nil
+			case *LogOnFailedEvent:
+				return &EResultError{Op: "logon", Result: e.Result}
+			case *SteamGuardRequiredEvent:
+				retrying = e.Retrying
+			case *DisconnectedEvent:
+				if retrying {
+					// Auth reconnects for the retry
+					retrying = false
+					continue
+				}
+				a.mutex.Lock()
+				transient := a.transientResult
+				a.mutex.Unlock()
+				if transient != steamlang.EResult_Invalid {
+					return &EResultError{Op: "logon", Result: transient}
+				}
+				if lastErr == nil {
+					lastErr = ErrNotConnected
+				}
+				return lastErr
+			case error:
+				lastErr = e
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 func (a *Auth) HandlePacket(packet *protocol.Packet) {
 	switch packet.EMsg {
 	case steamlang.EMsg_ClientLogOnResponse:
@@ -94,6 +305,10 @@ func (a *Auth) HandlePacket(packet *protocol.Packet) {
 		a.handleUpdateMachineAuth(packet)
 	case steamlang.EMsg_ClientAccountInfo:
 		a.handleAccountInfo(packet)
+	case steamlang.EMsg_ClientGameConnectTokens:
+		a.handleGameConnectTokens(packet)
+	case steamlang.EMsg_ClientTicketAuthComplete:
+		a.handleTicketAuthComplete(packet)
 	}
 }
 
@@ -106,6 +321,10 @@ func (a *Auth) handleLogOnResponse(packet *protocol.Packet) {
 	body := new(protobuf.CMsgClientLogonResponse)
 	msg := packet.ReadProtoMsg(body)
 
+	a.mutex.Lock()
+	a.guardRetry = false
+	a.mutex.Unlock()
+
 	result := steamlang.EResult(body.GetEresult())
 	if result == steamlang.EResult_OK {
 		atomic.StoreInt32(&a.client.sessionId, msg.Header.Proto.GetClientSessionid())
@@ -119,7 +338,8 @@ func (a *Auth) handleLogOnResponse(packet *protocol.Packet) {
 			a.client.Web.webLoginKey = ""
 		}
 
-		go a.client.heartbeatLoop(time.Duration(body.GetOutOfGameHeartbeatSeconds()))
+		a.client.log(LogAuth).Info("Logged on", "steamId", a.client.SteamId(), "sessionId", a.client.SessionId())
+		a.client.startHeartbeat(time.Duration(body.GetOutOfGameHeartbeatSeconds()) * time.Second)
 
 		a.client.Emit(&LoggedOnEvent{
 			Result:                    steamlang.EResult(body.GetEresult()),
@@ -143,7 +363,29 @@ func (a *Auth) handleLogOnResponse(packet *protocol.Packet) {
 		})
 	} else if result == steamlang.EResult_Fail || result == steamlang.EResult_ServiceUnavailable || result == steamlang.EResult_TryAnotherCM {
 		// some error on Steam's side, we'll get an EOF later
+		a.client.log(LogAuth).Warn("Logon failed on Steam's side", "result", result)
+		a.mutex.Lock()
+		a.transientResult = result
+		a.mutex.Unlock()
+	} else if guard := newSteamGuardRequiredEvent(result, body.GetEmailDomain()); guard != nil {
+		details := a.guardRetryDetails()
+		guard.Retrying = details != nil
+		a.client.log(LogAuth).Warn("Logon needs a Steam Guard code", "result", result, "emailDomain", guard.EmailDomain, "retrying", guard.Retrying)
+		a.client.Emit(guard)
+		if !guard.Retrying {
+			a.client.Emit(&LogOnFailedEvent{
+				Result: result,
+			})
+		}
+		a.client.Disconnect()
+		if guard.Retrying {
+			go a.retryWithGuardCode(details, guard)
+		}
 	} else {
+		a.client.log(LogAuth).Warn("Logon rejected", "result", result, "extendedResult", steamlang.EResult(body.GetEresultExtended()))
+		if result == steamlang.EResult_AccessDenied || result == steamlang.EResult_Expired {
+			a.forgetRefreshToken()
+		}
 		a.client.Emit(&LogOnFailedEvent{
 			Result: steamlang.EResult(body.GetEresult()),
 		})
@@ -151,18 +393,142 @@ func (a *Auth) handleLogOnResponse(packet *protocol.Packet) {
 	}
 }
 
+// Removes the refresh token of the last logon, which Steam has revoked or which has expired,
+// from the details and the CredentialStore so that it isn't used again.
+func (a *Auth) forgetRefreshToken() {
+	a.mutex.Lock()
+	username := ""
+	if a.details != nil && a.details.RefreshToken != "" {
+		a.details.RefreshToken = ""
+		username = a.details.Username
+	}
+	a.mutex.Unlock()
+	if username == "" {
+		return
+	}
+	a.client.log(LogAuth).Info("Removing the rejected refresh token", "username", username)
+	a.client.updateCredentials(username, func(creds *Credentials) {
+		creds.RefreshToken = ""
+	})
+}
+
 func (a *Auth) handleLoginKey(packet *protocol.Packet) {
 	body := new(protobuf.CMsgClientNewLoginKey)
 	packet.ReadProtoMsg(body)
 	a.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientNewLoginKeyAccepted, &protobuf.CMsgClientNewLoginKeyAccepted{
 		UniqueId: proto.Uint32(body.GetUniqueId()),
 	}))
+	a.mutex.Lock()
+	remember := a.details != nil && a.details.ShouldRememberPassword
+	username := ""
+	if remember {
+		a.details.LoginKey = body.GetLoginKey()
+		username = a.details.Username
+	}
+	a.mutex.Unlock()
+	if remember {
+		a.client.updateCredentials(username, func(creds *Credentials) {
+			creds.LoginKey = body.GetLoginKey()
+		})
+	}
+	a.client.log(LogAuth).Debug("Received login key", "uniqueId", body.GetUniqueId(), "remembered", remember)
 	a.client.Emit(&LoginKeyEvent{
 		UniqueId: body.GetUniqueId(),
 		LoginKey: body.GetLoginKey(),
 	})
 }
 
+// Returns the details of the last logon for logging on again, or nil if there was none.
+// Steam Guard codes are removed because they can only be used once, and if a login key
+// has been received or a refresh token was given, it is used instead of the password.
+func (a *Auth) relogonDetails() *LogOnDetails {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.details == nil {
+		return nil
+	}
+	details := *a.details
+	details.AuthCode = ""
+	details.TwoFactorCode = ""
+	if details.LoginKey != "" || details.RefreshToken != "" {
+		details.Password = ""
+	}
+	return &details
+}
+
+// Returns a function that logs on again like the last logon, or nil if there was none.
+func (a *Auth) relogon() func() {
+	a.mutex.Lock()
+	anon := a.anonymous
+	a.mutex.Unlock()
+	if anon != nil {
+		return func() {
+			a.logOnAnonymous(anon)
+		}
+	}
+	if details := a.relogonDetails(); details != nil {
+		return func() {
+			a.LogOn(details)
+		}
+	}
+	return nil
+}
+
+// The time LogOff waits for Steam to confirm the logoff, and then for the remaining messages to be sent.
+const DefaultLogOffTimeout = 5 * time.Second
+
+// Logs off and disconnects. Messages that were written before are sent first.
+// Blocks until Steam has confirmed the logoff with a LoggedOffEvent or DefaultLogOffTimeout has passed,
+// then sends any messages that were written in the meantime and closes the connection.
+// The DisconnectedEvent is marked as UserInitiated, so the reconnect supervisor doesn't reconnect.
+func (a *Auth) LogOff() {
+	a.LogOffContext(context.Background())
+}
+
+// Logs off like LogOff. If the context is cancelled, the client disconnects immediately
+// and the context's error is returned. Returns ErrNotConnected if the client isn't connected.
+func (a *Auth) LogOffContext(ctx context.Context) error {
+	c := a.client
+	c.mutex.Lock()
+	if c.conn == nil {
+		c.mutex.Unlock()
+		return ErrNotConnected
+	}
+	c.loggingOff = true
+	c.mutex.Unlock()
+	defer c.Disconnect()
+	c.log(LogAuth).Info("Logging off")
+
+	waitCtx, cancel := context.WithTimeout(ctx, DefaultLogOffTimeout)
+	err := c.waitFor(waitCtx, func() error {
+		c.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOff, new(protobuf.CMsgClientLogOff)))
+		return nil
+	}, func(event interface{}) (bool, error) {
+		switch event.(type) {
+		case *LoggedOffEvent:
+			return true, nil
+		case *DisconnectedEvent:
+			// Steam closed the connection, nothing left to send
+			return true, ErrNotConnected
+		}
+		return false, nil
+	})
+	cancel()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err == ErrNotConnected {
+		return nil
+	}
+
+	flushCtx, cancel := context.WithTimeout(ctx, DefaultLogOffTimeout)
+	defer cancel()
+	if err = c.Flush(flushCtx); err != nil && err != ErrNotConnected {
+		return err
+	}
+	return nil
+}
+
 func (a *Auth) handleLoggedOff(packet *protocol.Packet) {
 	result := steamlang.EResult_Invalid
 	if packet.IsProto {
@@ -174,6 +540,7 @@ func (a *Auth) handleLoggedOff(packet *protocol.Packet) {
 		packet.ReadClientMsg(body)
 		result = body.Result
 	}
+	a.client.log(LogAuth).Info("Logged off", "result", result)
 	a.client.Emit(&LoggedOffEvent{Result: result})
 }
 
@@ -190,6 +557,17 @@ func (a *Auth) handleUpdateMachineAuth(packet *protocol.Packet) {
 	msg.SetTargetJobId(packet.SourceJobId)
 	a.client.Write(msg)
 
+	a.mutex.Lock()
+	username := ""
+	if a.details != nil {
+		username = a.details.Username
+	}
+	a.mutex.Unlock()
+	a.client.updateCredentials(username, func(creds *Credentials) {
+		creds.SentryHash = sha
+	})
+
+	a.client.log(LogAuth).Info("Updated machine auth", "filename", body.GetFilename())
 	a.client.Emit(&MachineAuthUpdateEvent{sha})
 }
 
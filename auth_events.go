@@ -26,10 +26,42 @@ type LoggedOnEvent struct {
 	NumDisconnectsToMigrate   int32
 }
 
+// Emitted when Steam rejects a logon. It isn't emitted for temporary failures on Steam's side
+// (Fail, ServiceUnavailable and TryAnotherCM); the server closes the connection instead.
 type LogOnFailedEvent struct {
 	Result steamlang.EResult
 }
 
+// Emitted when Steam rejects a logon because it needs a Steam Guard code, before the LogOnFailedEvent.
+// If the LogOnDetails have a GuardCodeProvider, it is called with this event instead and the logon is
+// retried with the code, up to three times; the LogOnFailedEvent only follows if that fails.
+type SteamGuardRequiredEvent struct {
+	Result steamlang.EResult
+	// True if the code was sent by email, false if it is generated by the mobile authenticator.
+	Email bool
+	// The domain of the email address the code was sent to.
+	EmailDomain string
+	// True if Steam rejected the code of the previous attempt.
+	LastCodeWrong bool
+	// True if the logon is retried with a code from the GuardCodeProvider.
+	Retrying bool
+}
+
+// Emitted when Steam has validated an auth session ticket, on the client that created it
+// as well as on the game server that called BeginAuthSession.
+type TicketAuthCompleteEvent struct {
+	// The user the ticket belongs to.
+	SteamId steamid.SteamId
+	// The owner of the app, if the user borrowed it with Family Sharing.
+	OwnerSteamId steamid.SteamId
+	GameId       uint64
+	// The state of the ticket, as the EAuthTicketState of the Steamworks SDK.
+	State    uint32
+	Response steamlang.EAuthSessionResponse
+	// The CRC32 of the ticket, see AuthSessionTicket.Crc.
+	TicketCrc uint32
+}
+
 type LoginKeyEvent struct {
 	UniqueId uint32
 	LoginKey string
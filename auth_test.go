@@ -0,0 +1,237 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLogOnContextFailure(t *testing.T) {
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		packet, err := protocol.NewPacket(data)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if packet.EMsg != steamlang.EMsg_ClientLogon {
+			t.Errorf("Expected EMsg_ClientLogon, got %v", packet.EMsg)
+		}
+
+		buf := new(bytes.Buffer)
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+			Eresult: proto.Int32(int32(steamlang.EResult_InvalidPassword)),
+		}).Serialize(buf)
+		conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		conn.ReadMessage()
+	})
+
+	client := NewClient()
+	go func() {
+		for range client.Events() {
+		}
+	}()
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := client.Auth.LogOnContext(ctx, &LogOnDetails{
+		Username: "gopher",
+		Password: "hunter2",
+	})
+	var resultErr *EResultError
+	if !errors.As(err, &resultErr) {
+		t.Fatalf("Expected an EResultError, got %v", err)
+	}
+	if resultErr.Result != steamlang.EResult_InvalidPassword {
+		t.Fatalf("Expected EResult_InvalidPassword, got %v", resultErr.Result)
+	}
+}
+
+func TestLogOnContextTransientFailure(t *testing.T) {
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			t.Error(err)
+			return
+		}
+		buf := new(bytes.Buffer)
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+			Eresult: proto.Int32(int32(steamlang.EResult_TryAnotherCM)),
+		}).Serialize(buf)
+		conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		// Steam closes the connection after temporary failures
+	})
+
+	client := NewClient()
+	client.IgnoreEvents()
+	sub := client.Subscribe(&SubscribeOptions{Buffer: 32})
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := client.Auth.LogOnContext(ctx, &LogOnDetails{
+		Username: "gopher",
+		Password: "hunter2",
+	})
+	var resultErr *EResultError
+	if !errors.As(err, &resultErr) || resultErr.Result != steamlang.EResult_TryAnotherCM {
+		t.Fatalf("Expected an EResultError with EResult_TryAnotherCM, got %v", err)
+	}
+	sub.Unsubscribe()
+	for event := range sub.Events() {
+		if _, ok := event.(*LogOnFailedEvent); ok {
+			t.Fatal("Expected no LogOnFailedEvent for a temporary failure")
+		}
+	}
+}
+
+func TestLogOnContextNotConnected(t *testing.T) {
+	err := NewClient().Auth.LogOnContext(context.Background(), &LogOnDetails{
+		Username: "gopher",
+		Password: "hunter2",
+	})
+	if err != ErrNotConnected {
+		t.Fatalf("Expected ErrNotConnected, got %v", err)
+	}
+}
+
+func TestLogOnWithGuardCodeProvider(t *testing.T) {
+	const sharedSecret = "c2VjcmV0c2VjcmV0c2VjcmV0"
+	logons := make(chan *protobuf.CMsgClientLogon, 2)
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		packet, err := protocol.NewPacket(data)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		logon := new(protobuf.CMsgClientLogon)
+		packet.ReadProtoMsg(logon)
+		logons <- logon
+
+		result := steamlang.EResult_AccountLoginDeniedNeedTwoFactor
+		if logon.TwoFactorCode != nil {
+			result = steamlang.EResult_OK
+		}
+		buf := new(bytes.Buffer)
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+			Eresult: proto.Int32(int32(result)),
+		}).Serialize(buf)
+		conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		conn.ReadMessage()
+	})
+
+	client := NewClient()
+	guardEvents := make(chan *SteamGuardRequiredEvent, 4)
+	client.On(func(e *SteamGuardRequiredEvent) {
+		guardEvents <- e
+	}, nil)
+	go func() {
+		for range client.Events() {
+		}
+	}()
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var code string
+	err := client.Auth.LogOnContext(ctx, &LogOnDetails{
+		Username: "gopher",
+		Password: "hunter2",
+		GuardCodeProvider: func(e *SteamGuardRequiredEvent) (c string, err error) {
+			code, err = TotpGuardCodeProvider(sharedSecret)(e)
+			return code, err
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first := <-logons; first.TwoFactorCode != nil {
+		t.Fatalf("Expected a first logon without a code, got %v", first)
+	}
+	if second := <-logons; second.GetTwoFactorCode() != code || code == "" {
+		t.Fatalf("Expected a second logon with the code %q, got %v", code, second)
+	}
+	if e := <-guardEvents; e.Email || !e.Retrying || e.Result != steamlang.EResult_AccountLoginDeniedNeedTwoFactor {
+		t.Fatalf("Unexpected SteamGuardRequiredEvent %+v", e)
+	}
+}
+
+func TestLogOnAnonymous(t *testing.T) {
+	tests := []struct {
+		logOn       func(a *Auth)
+		eMsg        steamlang.EMsg
+		accountType steamlang.EAccountType
+		token       string
+	}{
+		{(*Auth).LogOnAnonymous, steamlang.EMsg_ClientLogon, steamlang.EAccountType_AnonUser, ""},
+		{func(a *Auth) { a.LogOnGameServer(440, "token") }, steamlang.EMsg_ClientLogonGameServer, steamlang.EAccountType_GameServer, "token"},
+		{func(a *Auth) { a.LogOnAnonymousGameServer(440) }, steamlang.EMsg_ClientLogon, steamlang.EAccountType_AnonGameServer, ""},
+	}
+	for _, test := range tests {
+		url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			packet, err := protocol.NewPacket(data)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			logon := new(protobuf.CMsgClientLogon)
+			msg := packet.ReadProtoMsg(logon)
+			accountType := steamlang.EAccountType(steamid.SteamId(msg.Header.Proto.GetSteamid()).GetAccountType())
+			if packet.EMsg != test.eMsg || accountType != test.accountType || logon.GetGameServerToken() != test.token || logon.AccountName != nil {
+				t.Errorf("Unexpected logon %v as %v: %v", packet.EMsg, accountType, logon)
+			}
+
+			buf := new(bytes.Buffer)
+			protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+				Eresult: proto.Int32(int32(steamlang.EResult_OK)),
+			}).Serialize(buf)
+			conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+			conn.ReadMessage()
+		})
+
+		client := NewClient()
+		if err := client.ConnectToWebSocket(url); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := nextEvent(t, client).(*ConnectedEvent); !ok {
+			t.Fatal("Expected a ConnectedEvent")
+		}
+		test.logOn(client.Auth)
+		if e, ok := nextEvent(t, client).(*LoggedOnEvent); !ok {
+			t.Fatalf("Expected a LoggedOnEvent, got %v", e)
+		}
+		client.Disconnect()
+	}
+}
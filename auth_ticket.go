@@ -0,0 +1,242 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"sync"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Returned by GetAuthSessionTicket if Steam hasn't sent any game connect tokens yet. They are sent shortly after logging on.
+var ErrNoGameConnectTokens = errors.New("steam: no game connect tokens")
+
+// Returned by GetAuthSessionTicket and BeginAuthSession if Steam didn't accept the ticket.
+var ErrTicketNotAccepted = errors.New("steam: auth ticket not accepted")
+
+// An auth session ticket of the logged on user, which a game server validates to
+// check that the user owns the app. See Auth.GetAuthSessionTicket.
+type AuthSessionTicket struct {
+	AppId uint32
+	// The CRC32 of the ticket, which identifies it in TicketAuthCompleteEvent.
+	Crc uint32
+	// The ticket to send to the game server.
+	Ticket []byte
+}
+
+// The game connect tokens and the tickets that are registered with Steam.
+type authTickets struct {
+	mutex           sync.Mutex // guarding all fields
+	tokens          [][]byte
+	tickets         []*protobuf.CMsgAuthTicket
+	ticketSequence  uint32
+	messageSequence uint32
+}
+
+// Creates an auth session ticket for the app from one of the game connect tokens Steam sent after
+// the logon, registers it with Steam and appends the app ownership ticket. Send the ticket to the
+// game server, which validates it with BeginAuthSession. Once it has, a TicketAuthCompleteEvent is emitted.
+// Cancel the ticket with CancelAuthSessionTicket when leaving the server.
+func (a *Auth) GetAuthSessionTicket(ctx context.Context, appId uint32) (*AuthSessionTicket, error) {
+	token, sequence := a.nextGameConnectToken()
+	if token == nil {
+		return nil, ErrNoGameConnectTokens
+	}
+	authToken := buildAuthToken(token, sequence)
+	crc := crc32.ChecksumIEEE(authToken)
+
+	ownership, err := a.client.Apps.GetAppOwnershipTicket(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+	err = a.addAuthTicket(ctx, &protobuf.CMsgAuthTicket{
+		Gameid:    proto.Uint64(uint64(appId)),
+		Ticket:    authToken,
+		TicketCrc: proto.Uint32(crc),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ticket := new(bytes.Buffer)
+	ticket.Write(authToken)
+	binary.Write(ticket, binary.LittleEndian, uint32(len(ownership)))
+	ticket.Write(ownership)
+	return &AuthSessionTicket{AppId: appId, Crc: crc, Ticket: ticket.Bytes()}, nil
+}
+
+// Tells Steam that the ticket is no longer used, which ends the session on the game server.
+func (a *Auth) CancelAuthSessionTicket(ctx context.Context, ticket *AuthSessionTicket) error {
+	return a.removeAuthTickets(ctx, func(t *protobuf.CMsgAuthTicket) bool {
+		return t.GetTicketCrc() == ticket.Crc
+	})
+}
+
+// Validates the ticket a user sent to this game server. Steam answers with a TicketAuthCompleteEvent
+// for the user's Steam ID, which tells whether the user may play. Call EndAuthSession when the user leaves.
+func (a *Auth) BeginAuthSession(ctx context.Context, user steamid.SteamId, appId uint32, ticket []byte) error {
+	return a.addAuthTicket(ctx, &protobuf.CMsgAuthTicket{
+		Steamid:   proto.Uint64(uint64(user)),
+		Gameid:    proto.Uint64(uint64(appId)),
+		Ticket:    ticket,
+		TicketCrc: proto.Uint32(authTicketCrc(ticket)),
+	})
+}
+
+// Ends the auth sessions of the user on this game server.
+func (a *Auth) EndAuthSession(ctx context.Context, user steamid.SteamId) error {
+	return a.removeAuthTickets(ctx, func(t *protobuf.CMsgAuthTicket) bool {
+		return t.GetSteamid() == uint64(user)
+	})
+}
+
+func (a *Auth) nextGameConnectToken() ([]byte, uint32) {
+	t := &a.tickets
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if len(t.tokens) == 0 {
+		return nil, 0
+	}
+	token := t.tokens[0]
+	t.tokens = t.tokens[1:]
+	t.ticketSequence++
+	return token, t.ticketSequence
+}
+
+// Returns the part of the ticket that the client builds: the game connect token and the session header.
+func buildAuthToken(token []byte, sequence uint32) []byte {
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.LittleEndian, uint32(len(token)))
+	buf.Write(token)
+	binary.Write(buf, binary.LittleEndian, uint32(24)) // the size of the session header
+	binary.Write(buf, binary.LittleEndian, uint32(1))
+	binary.Write(buf, binary.LittleEndian, uint32(2))
+	// the public and private IP, which the Steam client randomizes as well
+	random := make([]byte, 8)
+	rand.Read(random)
+	buf.Write(random)
+	binary.Write(buf, binary.LittleEndian, uint32(time.Now().UnixNano()/int64(time.Millisecond)))
+	binary.Write(buf, binary.LittleEndian, sequence)
+	return buf.Bytes()
+}
+
+// Returns the CRC32 of the auth token at the start of a ticket from GetAuthSessionTicket,
+// or of the whole ticket if it has a different format.
+func authTicketCrc(ticket []byte) uint32 {
+	if len(ticket) >= 4 {
+		tokenEnd := 4 + int(binary.LittleEndian.Uint32(ticket))
+		if tokenEnd >= 4 && len(ticket) >= tokenEnd+4 {
+			end := tokenEnd + 4 + int(binary.LittleEndian.Uint32(ticket[tokenEnd:]))
+			if end > tokenEnd && len(ticket) >= end {
+				return crc32.ChecksumIEEE(ticket[:end])
+			}
+		}
+	}
+	return crc32.ChecksumIEEE(ticket)
+}
+
+func (a *Auth) addAuthTicket(ctx context.Context, ticket *protobuf.CMsgAuthTicket) error {
+	t := &a.tickets
+	t.mutex.Lock()
+	t.tickets = append(t.tickets, ticket)
+	t.mutex.Unlock()
+
+	ack, err := a.sendAuthList(ctx)
+	if err == nil {
+		for _, crc := range ack.GetTicketCrc() {
+			if crc == ticket.GetTicketCrc() {
+				return nil
+			}
+		}
+		err = ErrTicketNotAccepted
+	}
+	a.forgetAuthTickets(func(t *protobuf.CMsgAuthTicket) bool {
+		return t == ticket
+	})
+	return err
+}
+
+func (a *Auth) removeAuthTickets(ctx context.Context, remove func(t *protobuf.CMsgAuthTicket) bool) error {
+	a.forgetAuthTickets(remove)
+	_, err := a.sendAuthList(ctx)
+	return err
+}
+
+// Removes tickets from the list without telling Steam.
+func (a *Auth) forgetAuthTickets(remove func(t *protobuf.CMsgAuthTicket) bool) {
+	t := &a.tickets
+	t.mutex.Lock()
+	kept := t.tickets[:0]
+	for _, ticket := range t.tickets {
+		if !remove(ticket) {
+			kept = append(kept, ticket)
+		}
+	}
+	t.tickets = kept
+	t.mutex.Unlock()
+}
+
+// Sends the list of all active tickets to Steam and waits for the acknowledgement.
+func (a *Auth) sendAuthList(ctx context.Context) (*protobuf.CMsgClientAuthListAck, error) {
+	t := &a.tickets
+	t.mutex.Lock()
+	t.messageSequence++
+	list := &protobuf.CMsgClientAuthList{
+		TokensLeft:      proto.Uint32(uint32(len(t.tokens))),
+		Tickets:         append([]*protobuf.CMsgAuthTicket(nil), t.tickets...),
+		MessageSequence: proto.Uint32(t.messageSequence),
+	}
+	seen := make(map[uint32]bool)
+	for _, ticket := range t.tickets {
+		if appId := uint32(ticket.GetGameid()); !seen[appId] {
+			seen[appId] = true
+			list.AppIds = append(list.AppIds, appId)
+		}
+	}
+	t.mutex.Unlock()
+
+	packet, err := a.client.Call(ctx, protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientAuthList, list))
+	if err != nil {
+		return nil, err
+	}
+	ack := new(protobuf.CMsgClientAuthListAck)
+	packet.ReadProtoMsg(ack)
+	return ack, nil
+}
+
+func (a *Auth) handleGameConnectTokens(packet *protocol.Packet) {
+	body := new(protobuf.CMsgClientGameConnectTokens)
+	packet.ReadProtoMsg(body)
+
+	t := &a.tickets
+	t.mutex.Lock()
+	t.tokens = append(t.tokens, body.GetTokens()...)
+	if max := int(body.GetMaxTokensToKeep()); len(t.tokens) > max {
+		t.tokens = t.tokens[len(t.tokens)-max:]
+	}
+	count := len(t.tokens)
+	t.mutex.Unlock()
+	a.client.log(LogAuth).Debug("Received game connect tokens", "received", len(body.GetTokens()), "stored", count)
+}
+
+func (a *Auth) handleTicketAuthComplete(packet *protocol.Packet) {
+	body := new(protobuf.CMsgClientTicketAuthComplete)
+	packet.ReadProtoMsg(body)
+	a.client.Emit(&TicketAuthCompleteEvent{
+		SteamId:      steamid.SteamId(body.GetSteamId()),
+		OwnerSteamId: steamid.SteamId(body.GetOwnerSteamId()),
+		GameId:       body.GetGameId(),
+		State:        body.GetEstate(),
+		Response:     steamlang.EAuthSessionResponse(body.GetEauthSessionResponse()),
+		TicketCrc:    body.GetTicketCrc(),
+	})
+}
@@ -0,0 +1,99 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAuthSessionTicket(t *testing.T) {
+	token := []byte("gctoken!")
+	lists := make(chan *protobuf.CMsgClientAuthList, 2)
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		send := func(msg *protocol.ClientMsgProtobuf) {
+			buf := new(bytes.Buffer)
+			msg.Serialize(buf)
+			conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		}
+		send(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientGameConnectTokens, &protobuf.CMsgClientGameConnectTokens{
+			MaxTokensToKeep: proto.Uint32(10),
+			Tokens:          [][]byte{token},
+		}))
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			packet, err := protocol.NewPacket(data)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			var reply *protocol.ClientMsgProtobuf
+			switch packet.EMsg {
+			case steamlang.EMsg_ClientGetAppOwnershipTicket:
+				reply = protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientGetAppOwnershipTicketResponse, &protobuf.CMsgClientGetAppOwnershipTicketResponse{
+					Eresult: proto.Uint32(uint32(steamlang.EResult_OK)),
+					AppId:   proto.Uint32(440),
+					Ticket:  []byte("ownership"),
+				})
+			case steamlang.EMsg_ClientAuthList:
+				list := new(protobuf.CMsgClientAuthList)
+				packet.ReadProtoMsg(list)
+				lists <- list
+				ack := &protobuf.CMsgClientAuthListAck{MessageSequence: list.MessageSequence}
+				for _, ticket := range list.Tickets {
+					ack.TicketCrc = append(ack.TicketCrc, ticket.GetTicketCrc())
+				}
+				reply = protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientAuthListAck, ack)
+			default:
+				t.Errorf("Unexpected message %v", packet.EMsg)
+				return
+			}
+			reply.SetTargetJobId(packet.SourceJobId)
+			send(reply)
+		}
+	})
+
+	client := connectForAuth(t, url)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var ticket *AuthSessionTicket
+	var err error
+	for ticket == nil {
+		// the tokens might not have arrived yet
+		if ticket, err = client.Auth.GetAuthSessionTicket(ctx, 440); err != nil && err != ErrNoGameConnectTokens {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if !bytes.HasPrefix(ticket.Ticket[4:], token) || !bytes.HasSuffix(ticket.Ticket, []byte("ownership")) {
+		t.Fatalf("Unexpected ticket %x", ticket.Ticket)
+	}
+	if size := binary.LittleEndian.Uint32(ticket.Ticket); size != uint32(len(token)) {
+		t.Fatalf("Expected the token size %v, got %v", len(token), size)
+	}
+	if crc := authTicketCrc(ticket.Ticket); crc != ticket.Crc {
+		t.Fatalf("Expected the CRC %x of the ticket, got %x", ticket.Crc, crc)
+	}
+	list := <-lists
+	if len(list.Tickets) != 1 || list.Tickets[0].GetTicketCrc() != ticket.Crc || list.AppIds[0] != 440 || list.GetTokensLeft() != 0 {
+		t.Fatalf("Unexpected auth list %v", list)
+	}
+
+	if err = client.Auth.CancelAuthSessionTicket(ctx, ticket); err != nil {
+		t.Fatal(err)
+	}
+	if list = <-lists; len(list.Tickets) != 0 || list.GetMessageSequence() != 2 {
+		t.Fatalf("Expected an empty auth list, got %v", list)
+	}
+}
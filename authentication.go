@@ -0,0 +1,401 @@
+package steam
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf/unified"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+	"google.golang.org/protobuf/proto"
+)
+
+// The details for logging on with IAuthenticationService, see Auth.BeginAuthSessionViaCredentials.
+type CredentialsDetails struct {
+	Username string
+	Password string
+
+	// If you have a Steam Guard email code, you can provide it here.
+	AuthCode string
+	// If you have a Steam Guard mobile two-factor authentication code, you can provide it here.
+	TwoFactorCode string
+	// The guard data of a previous session on this machine, see AuthTokens.NewGuardData.
+	// With it, Steam doesn't ask for an email code again.
+	GuardData string
+
+	// The name that is shown for this device in the account's authorized devices. Defaults to "go-steam".
+	DeviceFriendlyName string
+}
+
+// The tokens of a finished authentication session.
+type AuthTokens struct {
+	AccountName string
+	SteamId     steamid.SteamId `json:",string"`
+	// A long-lived token for logging on instead of the password, see LogOnDetails.RefreshToken.
+	RefreshToken string
+	// A short-lived token for the Web API.
+	AccessToken string
+	// If not empty, store this and pass it as CredentialsDetails.GuardData the next time.
+	NewGuardData string
+}
+
+// Returned if Steam asks for a Steam Guard code that wasn't given.
+type SteamGuardRequiredError struct {
+	// The kinds of codes Steam accepts: EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode
+	// and/or EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode.
+	Types []unified.EAuthSessionGuardType
+	// The domain of the email address the code was sent to, if Steam sent one.
+	EmailDomain string
+}
+
+func (e *SteamGuardRequiredError) Error() string {
+	return fmt.Sprintf("steam: Steam Guard code required (%v)", e.Types)
+}
+
+// A pending authentication session. It is finished by confirming it with a Steam Guard code,
+// in the Steam mobile app or by email, after which Poll returns the tokens.
+type AuthSession struct {
+	client      *Client
+	accountName string
+
+	ClientId  uint64
+	RequestId []byte
+	// The account's Steam ID. It is unknown for QR sessions until they are confirmed.
+	SteamId steamid.SteamId
+	// How often Steam wants the session to be polled.
+	Interval time.Duration
+	// The ways the session can be confirmed, in the order Steam prefers them.
+	AllowedConfirmations []*unified.CAuthentication_AllowedConfirmation
+	// For QR sessions, the URL that the Steam mobile app has to scan. Steam replaces it
+	// from time to time while the session is polled.
+	ChallengeUrl string
+}
+
+// Returns a session that was started earlier, possibly on another connection or by another process,
+// for example to submit a Steam Guard code the user receives later.
+func (a *Auth) ResumeAuthSession(clientId uint64, requestId []byte, steamId steamid.SteamId) *AuthSession {
+	return &AuthSession{
+		client:    a.client,
+		ClientId:  clientId,
+		RequestId: requestId,
+		SteamId:   steamId,
+		Interval:  pollInterval(0),
+	}
+}
+
+// Starts an authentication session with a username and password. The password is encrypted
+// with the account's RSA key first. The client must be connected, but not logged on.
+//
+// This doesn't submit any Steam Guard codes; use LogOnWithCredentials for the whole flow.
+func (a *Auth) BeginAuthSessionViaCredentials(ctx context.Context, details *CredentialsDetails) (*AuthSession, error) {
+	keyResp := new(unified.CAuthentication_GetPasswordRSAPublicKey_Response)
+	err := a.client.Unified.Call(ctx, "Authentication.GetPasswordRSAPublicKey#1", &unified.CAuthentication_GetPasswordRSAPublicKey_Request{
+		AccountName: proto.String(details.Username),
+	}, keyResp)
+	if err != nil {
+		return nil, err
+	}
+	password, err := encryptPassword(keyResp, details.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	name := details.DeviceFriendlyName
+	if name == "" {
+		name = "go-steam"
+	}
+	req := &unified.CAuthentication_BeginAuthSessionViaCredentials_Request{
+		DeviceFriendlyName:  proto.String(name),
+		AccountName:         proto.String(details.Username),
+		EncryptedPassword:   proto.String(password),
+		EncryptionTimestamp: proto.Uint64(keyResp.GetTimestamp()),
+		RememberLogin:       proto.Bool(true),
+		PlatformType:        unified.EAuthTokenPlatformType_k_EAuthTokenPlatformType_SteamClient.Enum(),
+		Persistence:         unified.ESessionPersistence_k_ESessionPersistence_Persistent.Enum(),
+		WebsiteId:           proto.String("Client"),
+	}
+	guardData := details.GuardData
+	if creds := a.client.loadCredentials(details.Username); guardData == "" && creds != nil {
+		guardData = creds.GuardData
+	}
+	if guardData != "" {
+		req.GuardData = proto.String(guardData)
+	}
+	resp := new(unified.CAuthentication_BeginAuthSessionViaCredentials_Response)
+	if err = a.client.Unified.Call(ctx, "Authentication.BeginAuthSessionViaCredentials#1", req, resp); err != nil {
+		return nil, err
+	}
+	a.client.log(LogAuth).Info("Began auth session", "username", details.Username, "clientId", resp.GetClientId())
+
+	return &AuthSession{
+		client:               a.client,
+		accountName:          details.Username,
+		ClientId:             resp.GetClientId(),
+		RequestId:            resp.GetRequestId(),
+		SteamId:              steamid.SteamId(resp.GetSteamid()),
+		Interval:             pollInterval(resp.GetInterval()),
+		AllowedConfirmations: resp.GetAllowedConfirmations(),
+	}, nil
+}
+
+// Starts an authentication session that is confirmed by scanning a QR code of the ChallengeUrl
+// with the Steam mobile app, so that no password is needed. The client must be connected, but not logged on.
+// If deviceFriendlyName is empty, "go-steam" is used.
+func (a *Auth) BeginAuthSessionViaQR(ctx context.Context, deviceFriendlyName string) (*AuthSession, error) {
+	if deviceFriendlyName == "" {
+		deviceFriendlyName = "go-steam"
+	}
+	resp := new(unified.CAuthentication_BeginAuthSessionViaQR_Response)
+	err := a.client.Unified.Call(ctx, "Authentication.BeginAuthSessionViaQR#1", &unified.CAuthentication_BeginAuthSessionViaQR_Request{
+		DeviceFriendlyName: proto.String(deviceFriendlyName),
+		PlatformType:       unified.EAuthTokenPlatformType_k_EAuthTokenPlatformType_SteamClient.Enum(),
+		WebsiteId:          proto.String("Client"),
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+	a.client.log(LogAuth).Info("Began QR auth session", "clientId", resp.GetClientId())
+
+	return &AuthSession{
+		client:               a.client,
+		ClientId:             resp.GetClientId(),
+		RequestId:            resp.GetRequestId(),
+		Interval:             pollInterval(resp.GetInterval()),
+		AllowedConfirmations: resp.GetAllowedConfirmations(),
+		ChallengeUrl:         resp.GetChallengeUrl(),
+	}, nil
+}
+
+// Encrypts the password with PKCS #1 v1.5 and the hex encoded key Steam sent.
+func encryptPassword(key *unified.CAuthentication_GetPasswordRSAPublicKey_Response, password string) (string, error) {
+	mod, ok := new(big.Int).SetString(key.GetPublickeyMod(), 16)
+	if !ok {
+		return "", errors.New("steam: invalid RSA modulus")
+	}
+	exp, err := strconv.ParseInt(key.GetPublickeyExp(), 16, 32)
+	if err != nil {
+		return "", fmt.Errorf("steam: invalid RSA exponent: %v", err)
+	}
+	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, &rsa.PublicKey{N: mod, E: int(exp)}, []byte(password))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func pollInterval(seconds float32) time.Duration {
+	if seconds <= 0 {
+		return 5 * time.Second
+	}
+	return time.Duration(seconds * float32(time.Second))
+}
+
+// Returns true if the session can be confirmed in the given way.
+func (s *AuthSession) Allows(guardType unified.EAuthSessionGuardType) bool {
+	for _, c := range s.AllowedConfirmations {
+		if c.GetConfirmationType() == guardType {
+			return true
+		}
+	}
+	return false
+}
+
+// Submits a Steam Guard code. codeType is EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode
+// or EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode.
+func (s *AuthSession) SubmitSteamGuardCode(ctx context.Context, code string, codeType unified.EAuthSessionGuardType) error {
+	return s.client.Unified.Call(ctx, "Authentication.UpdateAuthSessionWithSteamGuardCode#1", &unified.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request{
+		ClientId: proto.Uint64(s.ClientId),
+		Steamid:  proto.Uint64(uint64(s.SteamId)),
+		Code:     proto.String(code),
+		CodeType: codeType.Enum(),
+	}, nil)
+}
+
+// Asks Steam once whether the session has been confirmed.
+// Returns nil tokens without an error if it hasn't been yet.
+// The tokens are saved in the client's CredentialStore, if it has one.
+func (s *AuthSession) Poll(ctx context.Context) (*AuthTokens, error) {
+	resp := new(unified.CAuthentication_PollAuthSessionStatus_Response)
+	err := s.client.Unified.Call(ctx, "Authentication.PollAuthSessionStatus#1", &unified.CAuthentication_PollAuthSessionStatus_Request{
+		ClientId:  proto.Uint64(s.ClientId),
+		RequestId: s.RequestId,
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NewClientId != nil {
+		s.ClientId = resp.GetNewClientId()
+	}
+	if resp.NewChallengeUrl != nil {
+		s.ChallengeUrl = resp.GetNewChallengeUrl()
+	}
+	if resp.GetRefreshToken() == "" {
+		return nil, nil
+	}
+	if s.SteamId == 0 {
+		s.SteamId = steamIdFromToken(resp.GetRefreshToken())
+	}
+	tokens := &AuthTokens{
+		AccountName:  resp.GetAccountName(),
+		SteamId:      s.SteamId,
+		RefreshToken: resp.GetRefreshToken(),
+		AccessToken:  resp.GetAccessToken(),
+		NewGuardData: resp.GetNewGuardData(),
+	}
+	if tokens.AccountName == "" {
+		tokens.AccountName = s.accountName
+	}
+	s.client.updateCredentials(tokens.AccountName, func(creds *Credentials) {
+		creds.RefreshToken = tokens.RefreshToken
+		if tokens.NewGuardData != "" {
+			creds.GuardData = tokens.NewGuardData
+		}
+	})
+	return tokens, nil
+}
+
+// Returns the Steam ID in the subject of a JSON Web Token, or zero if it can't be read.
+func steamIdFromToken(token string) steamid.SteamId {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return 0
+	}
+	var claims struct {
+		Sub string `json:"sub"`
+	}
+	if json.Unmarshal(payload, &claims) != nil {
+		return 0
+	}
+	id, _ := strconv.ParseUint(claims.Sub, 10, 64)
+	return steamid.SteamId(id)
+}
+
+// Polls the session every Interval until it has been confirmed, the context is done or Steam
+// returns an error, for example EResult_Expired.
+func (s *AuthSession) Wait(ctx context.Context) (*AuthTokens, error) {
+	return s.wait(ctx, nil)
+}
+
+// Like Wait, but calls onChallengeUrl whenever Steam replaces the ChallengeUrl.
+func (s *AuthSession) wait(ctx context.Context, onChallengeUrl func(url string)) (*AuthTokens, error) {
+	url := s.ChallengeUrl
+	for {
+		tokens, err := s.Poll(ctx)
+		if tokens != nil || err != nil {
+			return tokens, err
+		}
+		if s.ChallengeUrl != url {
+			url = s.ChallengeUrl
+			if onChallengeUrl != nil {
+				onChallengeUrl(url)
+			}
+		}
+		select {
+		case <-time.After(s.Interval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Logs on with a username and password through IAuthenticationService and returns the tokens,
+// so that the RefreshToken can be stored and used for later logons instead of the password.
+//
+// If Steam accepts a Steam Guard code, the AuthCode or TwoFactorCode of the details is submitted,
+// even if the session could also be confirmed in the mobile app or by email. If neither of the accepted
+// codes was given and the session can't be confirmed elsewhere, a *SteamGuardRequiredError is returned;
+// otherwise this blocks until it is confirmed there.
+// Logon failures are returned as an *EResultError like LogOnContext does.
+func (a *Auth) LogOnWithCredentials(ctx context.Context, details *CredentialsDetails) (*AuthTokens, error) {
+	session, err := a.BeginAuthSessionViaCredentials(ctx, details)
+	if err != nil {
+		return nil, err
+	}
+	if err = session.submitCodes(ctx, details); err != nil {
+		return nil, err
+	}
+	tokens, err := session.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return a.logOnWithTokens(ctx, tokens)
+}
+
+// Logs on by scanning a QR code with the Steam mobile app and returns the tokens like LogOnWithCredentials.
+// onChallengeUrl is called with the URL to show as a QR code, and again whenever Steam replaces it.
+// Blocks until the logon has been approved in the app, the context is done or the session expired.
+func (a *Auth) LogOnWithQR(ctx context.Context, onChallengeUrl func(url string)) (*AuthTokens, error) {
+	session, err := a.BeginAuthSessionViaQR(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	onChallengeUrl(session.ChallengeUrl)
+	tokens, err := session.wait(ctx, onChallengeUrl)
+	if err != nil {
+		return nil, err
+	}
+	return a.logOnWithTokens(ctx, tokens)
+}
+
+func (a *Auth) logOnWithTokens(ctx context.Context, tokens *AuthTokens) (*AuthTokens, error) {
+	if tokens.AccountName == "" {
+		return nil, errors.New("steam: Steam didn't send the account name")
+	}
+	err := a.LogOnContext(ctx, &LogOnDetails{
+		Username:     tokens.AccountName,
+		RefreshToken: tokens.RefreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// Submits the code of the details that Steam allows, preferring it over confirming the session
+// elsewhere, so that a given code isn't ignored because the session could also be confirmed in the app.
+func (s *AuthSession) submitCodes(ctx context.Context, details *CredentialsDetails) error {
+	for _, c := range s.AllowedConfirmations {
+		switch c.GetConfirmationType() {
+		case unified.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode:
+			if details.TwoFactorCode != "" {
+				return s.SubmitSteamGuardCode(ctx, details.TwoFactorCode, c.GetConfirmationType())
+			}
+		case unified.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode:
+			if details.AuthCode != "" {
+				return s.SubmitSteamGuardCode(ctx, details.AuthCode, c.GetConfirmationType())
+			}
+		}
+	}
+
+	required := &SteamGuardRequiredError{}
+	for _, c := range s.AllowedConfirmations {
+		switch c.GetConfirmationType() {
+		case unified.EAuthSessionGuardType_k_EAuthSessionGuardType_None,
+			unified.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceConfirmation,
+			unified.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailConfirmation:
+			// nothing to submit, the session is confirmed elsewhere
+			return nil
+		case unified.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode:
+			required.Types = append(required.Types, c.GetConfirmationType())
+		case unified.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode:
+			required.Types = append(required.Types, c.GetConfirmationType())
+			required.EmailDomain = c.GetAssociatedMessage()
+		}
+	}
+	if len(required.Types) > 0 {
+		return required
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf/unified"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+// Starts a CM stand-in that answers the given Authentication methods and
+// accepts logons with refreshToken.
+func newAuthStandIn(t *testing.T, refreshToken string, methods map[string]func(packet *protocol.Packet) proto.Message) string {
+	return newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		reply := func(request *protocol.Packet, eMsg steamlang.EMsg, body proto.Message) {
+			msg := protocol.NewClientMsgProtobuf(eMsg, body)
+			msg.SetTargetJobId(request.SourceJobId)
+			msg.Header.Proto.Eresult = proto.Int32(int32(steamlang.EResult_OK))
+			buf := new(bytes.Buffer)
+			msg.Serialize(buf)
+			conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		}
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			packet, err := protocol.NewPacket(data)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if packet.EMsg == steamlang.EMsg_ClientLogon {
+				logon := new(protobuf.CMsgClientLogon)
+				packet.ReadProtoMsg(logon)
+				if logon.GetAccessToken() != refreshToken || logon.Password != nil {
+					t.Errorf("Expected a logon with only the refresh token, got %v", logon)
+				}
+				reply(packet, steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+					Eresult: proto.Int32(int32(steamlang.EResult_OK)),
+				})
+				continue
+			}
+			if packet.EMsg != steamlang.EMsg_ServiceMethodCallFromClientNonAuthed {
+				t.Errorf("Expected EMsg_ServiceMethodCallFromClientNonAuthed, got %v", packet.EMsg)
+				return
+			}
+
+			header := steamlang.NewMsgHdrProtoBuf()
+			header.Deserialize(bytes.NewReader(packet.Data))
+			method := header.Proto.GetTargetJobName()
+			handler, ok := methods[method]
+			if !ok {
+				t.Errorf("Unexpected method %v", method)
+				return
+			}
+			reply(packet, steamlang.EMsg_ServiceMethodResponse, handler(packet))
+		}
+	})
+}
+
+func connectForAuth(t *testing.T, url string) *Client {
+	client := NewClient()
+	go func() {
+		for range client.Events() {
+		}
+	}()
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Disconnect)
+	return client
+}
+
+func TestLogOnWithCredentials(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deviceCode := unified.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode
+	deviceConfirmation := unified.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceConfirmation
+
+	polls := 0
+	submitted := false
+	url := newAuthStandIn(t, "refresh", map[string]func(packet *protocol.Packet) proto.Message{
+		"Authentication.GetPasswordRSAPublicKey#1": func(packet *protocol.Packet) proto.Message {
+			return &unified.CAuthentication_GetPasswordRSAPublicKey_Response{
+				PublickeyMod: proto.String(key.N.Text(16)),
+				PublickeyExp: proto.String(fmt.Sprintf("%x", key.E)),
+				Timestamp:    proto.Uint64(1234),
+			}
+		},
+		"Authentication.BeginAuthSessionViaCredentials#1": func(packet *protocol.Packet) proto.Message {
+			req := new(unified.CAuthentication_BeginAuthSessionViaCredentials_Request)
+			packet.ReadProtoMsg(req)
+			encrypted, _ := base64.StdEncoding.DecodeString(req.GetEncryptedPassword())
+			password, err := rsa.DecryptPKCS1v15(rand.Reader, key, encrypted)
+			if err != nil || string(password) != "hunter2" || req.GetEncryptionTimestamp() != 1234 {
+				t.Errorf("Unexpected credentials %v, %q, %v", req, password, err)
+			}
+			return &unified.CAuthentication_BeginAuthSessionViaCredentials_Response{
+				ClientId:  proto.Uint64(1),
+				RequestId: []byte("request"),
+				Interval:  proto.Float32(0.01),
+				Steamid:   proto.Uint64(76561197960265728),
+				// the code has to be submitted even though the app could confirm the session
+				AllowedConfirmations: []*unified.CAuthentication_AllowedConfirmation{
+					{ConfirmationType: deviceConfirmation.Enum()},
+					{ConfirmationType: deviceCode.Enum()},
+				},
+			}
+		},
+		"Authentication.UpdateAuthSessionWithSteamGuardCode#1": func(packet *protocol.Packet) proto.Message {
+			req := new(unified.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request)
+			packet.ReadProtoMsg(req)
+			if req.GetCode() != "ABCDE" || req.GetCodeType() != deviceCode || req.GetClientId() != 1 {
+				t.Errorf("Unexpected Steam Guard code %v", req)
+			}
+			submitted = true
+			return &unified.CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response{}
+		},
+		"Authentication.PollAuthSessionStatus#1": func(packet *protocol.Packet) proto.Message {
+			polls++
+			resp := &unified.CAuthentication_PollAuthSessionStatus_Response{}
+			if polls > 1 && submitted {
+				resp.RefreshToken = proto.String("refresh")
+				resp.AccessToken = proto.String("access")
+				resp.AccountName = proto.String("gopher")
+			}
+			return resp
+		},
+	})
+	client := connectForAuth(t, url)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tokens, err := client.Auth.LogOnWithCredentials(ctx, &CredentialsDetails{
+		Username:      "gopher",
+		Password:      "hunter2",
+		TwoFactorCode: "ABCDE",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens.RefreshToken != "refresh" || tokens.AccessToken != "access" || tokens.SteamId != 76561197960265728 {
+		t.Fatalf("Unexpected tokens %+v", tokens)
+	}
+}
+
+func TestLogOnWithQR(t *testing.T) {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"76561197960265728"}`))
+	refreshToken := "eyJhbGciOiJFZERTQSJ9." + claims + ".signature"
+
+	polls := 0
+	url := newAuthStandIn(t, refreshToken, map[string]func(packet *protocol.Packet) proto.Message{
+		"Authentication.BeginAuthSessionViaQR#1": func(packet *protocol.Packet) proto.Message {
+			return &unified.CAuthentication_BeginAuthSessionViaQR_Response{
+				ClientId:     proto.Uint64(1),
+				RequestId:    []byte("request"),
+				Interval:     proto.Float32(0.01),
+				ChallengeUrl: proto.String("https://s.team/q/1/1"),
+			}
+		},
+		"Authentication.PollAuthSessionStatus#1": func(packet *protocol.Packet) proto.Message {
+			req := new(unified.CAuthentication_PollAuthSessionStatus_Request)
+			packet.ReadProtoMsg(req)
+			polls++
+			switch polls {
+			case 1:
+				return &unified.CAuthentication_PollAuthSessionStatus_Response{
+					NewClientId:     proto.Uint64(2),
+					NewChallengeUrl: proto.String("https://s.team/q/1/2"),
+				}
+			case 2:
+				if req.GetClientId() != 2 {
+					t.Errorf("Expected the new client id, got %v", req.GetClientId())
+				}
+				return &unified.CAuthentication_PollAuthSessionStatus_Response{}
+			}
+			return &unified.CAuthentication_PollAuthSessionStatus_Response{
+				RefreshToken: proto.String(refreshToken),
+				AccountName:  proto.String("gopher"),
+			}
+		},
+	})
+	client := connectForAuth(t, url)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var urls []string
+	tokens, err := client.Auth.LogOnWithQR(ctx, func(url string) {
+		urls = append(urls, url)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(urls) != 2 || urls[0] != "https://s.team/q/1/1" || urls[1] != "https://s.team/q/1/2" {
+		t.Fatalf("Unexpected challenge URLs %v", urls)
+	}
+	if tokens.AccountName != "gopher" || tokens.SteamId != 76561197960265728 {
+		t.Fatalf("Unexpected tokens %+v", tokens)
+	}
+}
+
+func TestSteamGuardRequired(t *testing.T) {
+	emailCode := unified.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode
+	session := &AuthSession{
+		AllowedConfirmations: []*unified.CAuthentication_AllowedConfirmation{
+			{ConfirmationType: emailCode.Enum(), AssociatedMessage: proto.String("example.com")},
+		},
+	}
+	err := session.submitCodes(context.Background(), &CredentialsDetails{TwoFactorCode: "ABCDE"})
+	var required *SteamGuardRequiredError
+	if !errors.As(err, &required) {
+		t.Fatalf("Expected a SteamGuardRequiredError, got %v", err)
+	}
+	if len(required.Types) != 1 || required.Types[0] != emailCode || required.EmailDomain != "example.com" {
+		t.Fatalf("Unexpected error %+v", required)
+	}
+}
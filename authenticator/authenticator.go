@@ -0,0 +1,86 @@
+/*
+Package authenticator adds a Steam Guard mobile authenticator to an account and stores its secrets
+in the .maFile format of Steam Desktop Authenticator, so that authenticators can be moved between
+this library and other tools.
+
+Enrollment needs a logged on client and the code Steam sends by SMS or email:
+
+	enrollment, err := authenticator.Add(ctx, client)
+	if err != nil {
+		return err
+	}
+	// save the secrets before finalizing, the revocation code is needed to undo a lost authenticator
+	enrollment.Authenticator.Save(enrollment.Authenticator.AccountName + ".maFile")
+	err = enrollment.Finalize(ctx, codeFromSms)
+
+The secrets then generate logon codes and confirmation keys:
+
+	a, err := authenticator.Load("gopher.maFile")
+	details.GuardCodeProvider = steam.TotpGuardCodeProvider(a.SharedSecret)
+	c := confirmation.NewClient(steamId, a.IdentitySecret, ...)
+*/
+package authenticator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/totp"
+)
+
+// The secrets of a mobile authenticator, with the field names of a Steam Desktop Authenticator .maFile.
+type Authenticator struct {
+	// Generates the Steam Guard codes, base64-encoded.
+	SharedSecret string `json:"shared_secret"`
+	SerialNumber string `json:"serial_number"`
+	// Removes the authenticator from the account if the secrets are lost.
+	RevocationCode string `json:"revocation_code"`
+	// The otpauth URI of the authenticator.
+	Uri         string `json:"uri"`
+	ServerTime  int64  `json:"server_time"`
+	AccountName string `json:"account_name"`
+	TokenGid    string `json:"token_gid"`
+	// Generates the keys of mobile confirmations, base64-encoded.
+	IdentitySecret string `json:"identity_secret"`
+	Secret1        string `json:"secret_1"`
+	Status         int32  `json:"status"`
+	DeviceId       string `json:"device_id"`
+	// False until the enrollment has been finalized.
+	FullyEnrolled bool `json:"fully_enrolled"`
+	// The web session Steam Desktop Authenticator stores with the secrets. It isn't used by this package
+	// and is only kept so that it survives loading and saving a file.
+	Session json.RawMessage `json:"Session,omitempty"`
+}
+
+// Parses the JSON of a .maFile.
+func Parse(data []byte) (*Authenticator, error) {
+	a := new(Authenticator)
+	if err := json.Unmarshal(data, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Reads a .maFile. Files that Steam Desktop Authenticator encrypted are not supported.
+func Load(path string) (*Authenticator, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Writes the authenticator to a .maFile that only the current user can read.
+func (a *Authenticator) Save(path string) error {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+// Generates the Steam Guard code for the given time.
+func (a *Authenticator) GenerateCode(t time.Time) (string, error) {
+	return totp.GenerateTotpCode(a.SharedSecret, t)
+}
@@ -0,0 +1,208 @@
+package authenticator
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3"
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf/unified"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamtest"
+	"google.golang.org/protobuf/proto"
+)
+
+const maFile = `{"shared_secret":"AAAAAAAAAAAAAAAAAAAAAAAAAAA=","serial_number":"1234","revocation_code":"R12345","uri":"otpauth://totp/Steam:gopher?secret=AAAA&issuer=Steam","server_time":1685025431,"account_name":"gopher","token_gid":"gid","identity_secret":"AAAAAAAAAAAAAAAAAAAAAAAAAAA=","secret_1":"AAAA","status":1,"device_id":"android:1","fully_enrolled":true,"Session":{"SteamID":76561197960287930,"SessionID":"abc"}}`
+
+func TestLoadAndSave(t *testing.T) {
+	a, err := Parse([]byte(maFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.AccountName != "gopher" || a.RevocationCode != "R12345" || !a.FullyEnrolled {
+		t.Fatalf("unexpected authenticator %+v", a)
+	}
+	path := filepath.Join(t.TempDir(), "gopher.maFile")
+	if err = a.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(loaded.Session) != `{"SteamID":76561197960287930,"SessionID":"abc"}` {
+		t.Fatalf("session was not kept: %s", loaded.Session)
+	}
+	code, err := loaded.GenerateCode(time.Unix(1685025431, 0))
+	if err != nil || len(code) != 5 {
+		t.Fatalf("unexpected code %q: %v", code, err)
+	}
+}
+
+// Answers the unified calls of the client with the responses of handle.
+func handleTwoFactor(server *steamtest.Server, handle func(method string, packet *protocol.Packet) proto.Message) {
+	server.Handle(steamlang.EMsg_ServiceMethodCallFromClient, func(conn *steamtest.Conn, packet *protocol.Packet) {
+		header := steamlang.NewMsgHdrProtoBuf()
+		header.Deserialize(bytes.NewBuffer(packet.Data))
+		reply := protocol.NewClientMsgProtobuf(steamlang.EMsg_ServiceMethodResponse, handle(header.Proto.GetTargetJobName(), packet))
+		reply.SetTargetJobId(packet.SourceJobId)
+		reply.Header.Proto.Eresult = proto.Int32(int32(steamlang.EResult_OK))
+		conn.Send(reply)
+	})
+}
+
+// Returns a client that is logged on to the server.
+func logOnTo(t *testing.T, server *steamtest.Server) *steam.Client {
+	client := server.NewClient()
+	client.IgnoreEvents()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.ConnectToContext(ctx, server.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Auth.LogOnContext(ctx, &steam.LogOnDetails{Username: "gopher", Password: "hunter2"}); err != nil {
+		client.Disconnect()
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestEnrollment(t *testing.T) {
+	server := steamtest.NewUnstartedServer()
+	var finalized []uint64
+	handleTwoFactor(server, func(method string, packet *protocol.Packet) proto.Message {
+		switch method {
+		case "TwoFactor.AddAuthenticator#1":
+			return &unified.CTwoFactor_AddAuthenticator_Response{
+				SharedSecret:   []byte("shared secret"),
+				IdentitySecret: []byte("identity secret"),
+				SerialNumber:   proto.Uint64(1234),
+				RevocationCode: proto.String("R12345"),
+				AccountName:    proto.String("gopher"),
+				ServerTime:     proto.Uint64(uint64(time.Now().Unix())),
+				Status:         proto.Int32(int32(steamlang.EResult_OK)),
+				ConfirmType:    proto.Int32(int32(ConfirmTypeSms)),
+			}
+		case "TwoFactor.FinalizeAddAuthenticator#1":
+			req := new(unified.CTwoFactor_FinalizeAddAuthenticator_Request)
+			packet.ReadProtoMsg(req)
+			if req.GetActivationCode() != "54321" {
+				return &unified.CTwoFactor_FinalizeAddAuthenticator_Response{
+					Status: proto.Int32(int32(steamlang.EResult_TwoFactorActivationCodeMismatch)),
+				}
+			}
+			finalized = append(finalized, req.GetAuthenticatorTime())
+			return &unified.CTwoFactor_FinalizeAddAuthenticator_Response{
+				Success:  proto.Bool(true),
+				WantMore: proto.Bool(len(finalized) < 2),
+				Status:   proto.Int32(int32(steamlang.EResult_OK)),
+			}
+		}
+		t.Errorf("unexpected call to %v", method)
+		return new(unified.CTwoFactor_Time_Response)
+	})
+	server.Start()
+	defer server.Close()
+
+	client := logOnTo(t, server)
+	defer client.Disconnect()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	enrollment, err := Add(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := enrollment.Authenticator
+	if a.SharedSecret != "c2hhcmVkIHNlY3JldA==" || a.SerialNumber != "1234" || a.RevocationCode != "R12345" || a.FullyEnrolled {
+		t.Fatalf("unexpected authenticator %+v", a)
+	}
+	if enrollment.ConfirmType != ConfirmTypeSms {
+		t.Fatalf("unexpected confirm type %v", enrollment.ConfirmType)
+	}
+	if err = enrollment.Finalize(ctx, "00000"); err != ErrBadActivationCode {
+		t.Fatalf("expected ErrBadActivationCode, got %v", err)
+	}
+	if err = enrollment.Finalize(ctx, "54321"); err != nil {
+		t.Fatal(err)
+	}
+	if !a.FullyEnrolled || len(finalized) != 2 || finalized[1] != finalized[0]+30 {
+		t.Fatalf("unexpected finalization %v, enrolled %v", finalized, a.FullyEnrolled)
+	}
+}
+
+func TestFinalizeNextTimeWindow(t *testing.T) {
+	server := steamtest.NewUnstartedServer()
+	var finalized []uint64
+	handleTwoFactor(server, func(method string, packet *protocol.Packet) proto.Message {
+		switch method {
+		case "TwoFactor.AddAuthenticator#1":
+			return &unified.CTwoFactor_AddAuthenticator_Response{
+				SharedSecret: []byte("shared secret"),
+				Status:       proto.Int32(int32(steamlang.EResult_OK)),
+			}
+		case "TwoFactor.FinalizeAddAuthenticator#1":
+			req := new(unified.CTwoFactor_FinalizeAddAuthenticator_Request)
+			packet.ReadProtoMsg(req)
+			finalized = append(finalized, req.GetAuthenticatorTime())
+			if len(finalized) == 1 {
+				return &unified.CTwoFactor_FinalizeAddAuthenticator_Response{
+					Status: proto.Int32(int32(steamlang.EResult_TwoFactorCodeMismatch)),
+				}
+			}
+			return &unified.CTwoFactor_FinalizeAddAuthenticator_Response{
+				Success: proto.Bool(true),
+				Status:  proto.Int32(int32(steamlang.EResult_OK)),
+			}
+		}
+		t.Errorf("unexpected call to %v", method)
+		return new(unified.CTwoFactor_Time_Response)
+	})
+	server.Start()
+	defer server.Close()
+
+	client := logOnTo(t, server)
+	defer client.Disconnect()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	enrollment, err := Add(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = enrollment.Finalize(ctx, "54321"); err != nil {
+		t.Fatal(err)
+	}
+	if !enrollment.Authenticator.FullyEnrolled || len(finalized) != 2 || finalized[1] != finalized[0]+30 {
+		t.Fatalf("unexpected finalization %v, enrolled %v", finalized, enrollment.Authenticator.FullyEnrolled)
+	}
+}
+
+func TestAddFails(t *testing.T) {
+	server := steamtest.NewUnstartedServer()
+	var status steamlang.EResult
+	handleTwoFactor(server, func(method string, packet *protocol.Packet) proto.Message {
+		return &unified.CTwoFactor_AddAuthenticator_Response{Status: proto.Int32(int32(status))}
+	})
+	server.Start()
+	defer server.Close()
+
+	client := logOnTo(t, server)
+	defer client.Disconnect()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	status = steamlang.EResult_NoMobileDevice
+	if _, err := Add(ctx, client); err != ErrNoPhoneNumber {
+		t.Fatalf("expected ErrNoPhoneNumber, got %v", err)
+	}
+	status = steamlang.EResult_Fail
+	if _, err := Add(ctx, client); err == nil {
+		t.Fatal("expected an EResultError")
+	} else if e, ok := err.(*steam.EResultError); !ok || e.Result != steamlang.EResult_Fail {
+		t.Fatalf("expected an EResultError, got %v", err)
+	}
+}
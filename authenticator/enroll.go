@@ -0,0 +1,173 @@
+package authenticator
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3"
+	"github.com/Philipp15b/go-steam/v3/confirmation"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf/unified"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+	"github.com/Philipp15b/go-steam/v3/totp"
+	"google.golang.org/protobuf/proto"
+)
+
+// Returned by Add if Steam answers with EResult_NoMobileDevice because the account has no phone number,
+// which Steam requires for a mobile authenticator.
+var ErrNoPhoneNumber = errors.New("authenticator: account has no phone number")
+
+// Returned by Add if the account already has a mobile authenticator.
+var ErrAlreadyEnrolled = errors.New("authenticator: account already has an authenticator")
+
+// Returned by Finalize if the code from the SMS or email is wrong.
+var ErrBadActivationCode = errors.New("authenticator: wrong activation code")
+
+// Returned by Remove if the revocation code is wrong.
+var ErrBadRevocationCode = errors.New("authenticator: wrong revocation code")
+
+// How Steam sends the activation code of a new authenticator.
+type ConfirmType int32
+
+const (
+	ConfirmTypeSms   ConfirmType = 1
+	ConfirmTypeEmail ConfirmType = 3
+)
+
+// The number of codes Finalize sends at most until Steam has enough of them.
+const maxFinalizeAttempts = 30
+
+// An authenticator that was added to an account but still needs to be finalized with the
+// activation code. Until then, the account keeps using its previous Steam Guard method.
+type Enrollment struct {
+	client  *steam.Client
+	steamId steamid.SteamId
+	// the difference between the server time and the local time
+	offset time.Duration
+
+	// The secrets of the new authenticator. Save them before calling Finalize.
+	Authenticator *Authenticator
+	// How Steam sent the activation code.
+	ConfirmType ConfirmType
+	// The last digits of the phone number the SMS was sent to.
+	PhoneNumberHint string
+}
+
+// Adds a mobile authenticator to the account the client is logged on to. Steam sends an
+// activation code by SMS or email, which is passed to Enrollment.Finalize.
+func Add(ctx context.Context, client *steam.Client) (*Enrollment, error) {
+	steamId := client.SteamId()
+	deviceId := confirmation.DeviceId(steamId)
+	resp := new(unified.CTwoFactor_AddAuthenticator_Response)
+	err := client.Unified.Call(ctx, "TwoFactor.AddAuthenticator#1", &unified.CTwoFactor_AddAuthenticator_Request{
+		Steamid:           proto.Uint64(uint64(steamId)),
+		AuthenticatorTime: proto.Uint64(uint64(time.Now().Unix())),
+		AuthenticatorType: proto.Uint32(1),
+		DeviceIdentifier:  proto.String(deviceId),
+		SmsPhoneId:        proto.String("1"),
+	}, resp)
+	if err != nil {
+		return nil, err
+	}
+	switch result := steamlang.EResult(resp.GetStatus()); result {
+	case steamlang.EResult_OK:
+	case steamlang.EResult_NoMobileDevice:
+		return nil, ErrNoPhoneNumber
+	case steamlang.EResult_DuplicateRequest:
+		return nil, ErrAlreadyEnrolled
+	default:
+		return nil, &steam.EResultError{Op: "TwoFactor.AddAuthenticator#1", Result: result}
+	}
+
+	return &Enrollment{
+		client:  client,
+		steamId: steamId,
+		offset:  serverTimeOffset(resp.GetServerTime()),
+		Authenticator: &Authenticator{
+			SharedSecret:   base64.StdEncoding.EncodeToString(resp.GetSharedSecret()),
+			SerialNumber:   strconv.FormatUint(resp.GetSerialNumber(), 10),
+			RevocationCode: resp.GetRevocationCode(),
+			Uri:            resp.GetUri(),
+			ServerTime:     int64(resp.GetServerTime()),
+			AccountName:    resp.GetAccountName(),
+			TokenGid:       resp.GetTokenGid(),
+			IdentitySecret: base64.StdEncoding.EncodeToString(resp.GetIdentitySecret()),
+			Secret1:        base64.StdEncoding.EncodeToString(resp.GetSecret_1()),
+			Status:         resp.GetStatus(),
+			DeviceId:       deviceId,
+		},
+		ConfirmType:     ConfirmType(resp.GetConfirmType()),
+		PhoneNumberHint: resp.GetPhoneNumberHint(),
+	}, nil
+}
+
+// Activates the authenticator with the code Steam sent by SMS or email. Steam may ask for the codes
+// of several consecutive time windows, which are generated from the shared secret without waiting.
+// Afterwards, Authenticator.FullyEnrolled is true and the account uses the authenticator for Steam Guard.
+func (e *Enrollment) Finalize(ctx context.Context, activationCode string) error {
+	t := time.Now().Add(e.offset)
+	for i := 0; i < maxFinalizeAttempts; i++ {
+		code, err := totp.GenerateTotpCode(e.Authenticator.SharedSecret, t)
+		if err != nil {
+			return err
+		}
+		resp := new(unified.CTwoFactor_FinalizeAddAuthenticator_Response)
+		err = e.client.Unified.Call(ctx, "TwoFactor.FinalizeAddAuthenticator#1", &unified.CTwoFactor_FinalizeAddAuthenticator_Request{
+			Steamid:           proto.Uint64(uint64(e.steamId)),
+			AuthenticatorCode: proto.String(code),
+			AuthenticatorTime: proto.Uint64(uint64(t.Unix())),
+			ActivationCode:    proto.String(activationCode),
+			ValidateSmsCode:   proto.Bool(true),
+		}, resp)
+		if err != nil {
+			return err
+		}
+		switch result := steamlang.EResult(resp.GetStatus()); {
+		case result == steamlang.EResult_TwoFactorActivationCodeMismatch:
+			return ErrBadActivationCode
+		case result == steamlang.EResult_TwoFactorCodeMismatch:
+			// the code was for a time window Steam didn't expect, so try the next one
+			t = t.Add(30 * time.Second)
+			continue
+		case !resp.GetSuccess():
+			return &steam.EResultError{Op: "TwoFactor.FinalizeAddAuthenticator#1", Result: result}
+		}
+		if !resp.GetWantMore() {
+			e.Authenticator.FullyEnrolled = true
+			return nil
+		}
+		if resp.GetServerTime() != 0 {
+			t = time.Unix(int64(resp.GetServerTime()), 0)
+		}
+		t = t.Add(30 * time.Second)
+	}
+	return errors.New("authenticator: Steam still wants more codes")
+}
+
+// Removes the mobile authenticator from the account the client is logged on to, which returns
+// it to Steam Guard codes by email.
+func Remove(ctx context.Context, client *steam.Client, revocationCode string) error {
+	resp := new(unified.CTwoFactor_RemoveAuthenticator_Response)
+	err := client.Unified.Call(ctx, "TwoFactor.RemoveAuthenticator#1", &unified.CTwoFactor_RemoveAuthenticator_Request{
+		RevocationCode:   proto.String(revocationCode),
+		RevocationReason: proto.Uint32(1),
+		SteamguardScheme: proto.Uint32(1),
+	}, resp)
+	if err != nil {
+		return err
+	}
+	if !resp.GetSuccess() {
+		return ErrBadRevocationCode
+	}
+	return nil
+}
+
+func serverTimeOffset(serverTime uint64) time.Duration {
+	if serverTime == 0 {
+		return 0
+	}
+	return time.Unix(int64(serverTime), 0).Sub(time.Now()).Round(time.Second)
+}
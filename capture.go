@@ -0,0 +1,224 @@
+package steam
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+)
+
+// The direction of a captured packet.
+type CaptureDirection string
+
+const (
+	// A packet received from Steam.
+	CaptureInbound CaptureDirection = "in"
+	// A packet sent to Steam.
+	CaptureOutbound CaptureDirection = "out"
+)
+
+// A decrypted packet as it is stored in a capture file.
+type CapturedPacket struct {
+	Time        time.Time
+	Direction   CaptureDirection
+	EMsg        steamlang.EMsg
+	IsProto     bool
+	TargetJobId protocol.JobId `json:",string"`
+	SourceJobId protocol.JobId `json:",string"`
+	Data        []byte
+}
+
+// Returns the captured packet so that it can be passed to a PacketHandler.
+func (p *CapturedPacket) Packet() (*protocol.Packet, error) {
+	return protocol.NewPacket(p.Data)
+}
+
+// Writes captured packets as JSON, one per line.
+// All methods are safe for concurrent use.
+type PacketRecorder struct {
+	mutex sync.Mutex
+	enc   *json.Encoder
+	err   error
+}
+
+func NewPacketRecorder(w io.Writer) *PacketRecorder {
+	return &PacketRecorder{
+		enc: json.NewEncoder(w),
+	}
+}
+
+// Records a packet. After the first error, nothing is written anymore and the error is returned.
+func (r *PacketRecorder) Record(direction CaptureDirection, packet *protocol.Packet) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.err = r.enc.Encode(&CapturedPacket{
+		Time:        time.Now(),
+		Direction:   direction,
+		EMsg:        packet.EMsg,
+		IsProto:     packet.IsProto,
+		TargetJobId: packet.TargetJobId,
+		SourceJobId: packet.SourceJobId,
+		Data:        packet.Data,
+	})
+	return r.err
+}
+
+// Returns the first error that occurred while writing.
+func (r *PacketRecorder) Err() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.err
+}
+
+// Reads a capture written by a PacketRecorder.
+type PacketReader struct {
+	dec *json.Decoder
+}
+
+func NewPacketReader(r io.Reader) *PacketReader {
+	return &PacketReader{
+		dec: json.NewDecoder(r),
+	}
+}
+
+// Returns the next packet, or io.EOF at the end of the capture.
+func (r *PacketReader) Next() (*CapturedPacket, error) {
+	p := new(CapturedPacket)
+	if err := r.dec.Decode(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Records every decrypted packet that is received or sent from now on.
+// Pass nil to stop recording. Errors while recording are emitted once.
+//
+// The password, login key, access token, Steam Guard codes and sentry hash are removed from the
+// ClientLogon that is recorded. Other packets are recorded as they are, so a capture still contains
+// secrets that Steam sends, like new login keys, sentry files and the tokens of authentication
+// sessions. Treat captures like credentials and don't share them.
+func (c *Client) SetCapture(recorder *PacketRecorder) {
+	c.mutex.Lock()
+	c.capture = recorder
+	c.mutex.Unlock()
+}
+
+func (c *Client) recorder() *PacketRecorder {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.capture
+}
+
+func (c *Client) capturePacket(direction CaptureDirection, packet *protocol.Packet) {
+	recorder := c.recorder()
+	if recorder == nil || recorder.Err() != nil {
+		return
+	}
+	if err := recorder.Record(direction, packet); err != nil {
+		c.Errorf("Error capturing packet %v: %v", packet, err)
+	}
+}
+
+// Records a message that has been serialized to data for sending.
+func (c *Client) captureOutbound(data []byte) {
+	if c.recorder() == nil {
+		return
+	}
+	packet, err := protocol.NewPacket(append([]byte(nil), data...))
+	if err == nil && packet.EMsg == steamlang.EMsg_ClientLogon && packet.IsProto {
+		packet, err = redactLogOn(packet)
+	}
+	if err != nil {
+		c.Errorf("Error capturing packet: %v", err)
+		return
+	}
+	c.capturePacket(CaptureOutbound, packet)
+}
+
+// Returns a copy of the ClientLogon packet without the secrets it contains.
+func redactLogOn(packet *protocol.Packet) (*protocol.Packet, error) {
+	body := new(protobuf.CMsgClientLogon)
+	msg := packet.ReadProtoMsg(body)
+	body.Password = nil
+	body.LoginKey = nil
+	body.AccessToken = nil
+	body.GameServerToken = nil
+	body.AuthCode = nil
+	body.TwoFactorCode = nil
+	body.ShaSentryfile = nil
+	buf := new(bytes.Buffer)
+	if err := msg.Serialize(buf); err != nil {
+		return nil, err
+	}
+	return protocol.NewPacket(buf.Bytes())
+}
+
+// Connects to a capture instead of a server. The inbound packets of the capture are passed to the
+// packet handlers in order, as if they had been received from Steam, and everything the client
+// sends is discarded. This lets you reproduce the behaviour of the handlers deterministically.
+//
+// The events depend on the capture: if it starts with the channel encryption handshake, a ConnectedEvent
+// is emitted as usual. When the end of the capture is reached, the client disconnects with a FatalErrorEvent
+// like it does when a server closes the connection.
+// If this client is already connected, it is disconnected first.
+func (c *Client) ConnectToReplay(r io.Reader) error {
+	c.Disconnect()
+	c.setConnection(&replayConnection{
+		reader: NewPacketReader(r),
+		closed: make(chan struct{}),
+	})
+	return nil
+}
+
+// A connection that reads the inbound packets of a capture.
+type replayConnection struct {
+	reader    *PacketReader
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+var errReplayClosed = errors.New("replay connection closed")
+
+func (c *replayConnection) Read() (*protocol.Packet, error) {
+	for {
+		select {
+		case <-c.closed:
+			return nil, errReplayClosed
+		default:
+		}
+		captured, err := c.reader.Next()
+		if err != nil {
+			return nil, err
+		}
+		if captured.Direction == CaptureInbound {
+			return captured.Packet()
+		}
+	}
+}
+
+func (c *replayConnection) Write(message []byte) error {
+	return nil
+}
+
+func (c *replayConnection) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	return nil
+}
+
+func (c *replayConnection) SetEncryptionKey(key, hmacSecret []byte) {}
+
+// The capture contains decrypted packets.
+func (c *replayConnection) IsEncrypted() bool {
+	return true
+}
@@ -0,0 +1,141 @@
+package steam
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCaptureAndReplay(t *testing.T) {
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			t.Error(err)
+			return
+		}
+		buf := new(bytes.Buffer)
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientAccountInfo, &protobuf.CMsgClientAccountInfo{
+			PersonaName: proto.String("gopher"),
+		}).Serialize(buf)
+		conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		conn.ReadMessage()
+	})
+
+	capture := new(lockedBuffer)
+	recorder := NewPacketRecorder(capture)
+	client := NewClient()
+	client.SetCapture(recorder)
+	client.IgnoreEvents()
+	sub := client.Subscribe(nil)
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientHeartBeat, new(protobuf.CMsgClientHeartBeat)))
+	waitForAccountInfo(t, sub)
+	// handlers may still write after the disconnect, so only the start of the capture is stable
+	client.Disconnect()
+	if err := recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	data := capture.Bytes()
+	reader := NewPacketReader(bytes.NewReader(data))
+	for _, expected := range []struct {
+		direction CaptureDirection
+		eMsg      steamlang.EMsg
+	}{
+		{CaptureOutbound, steamlang.EMsg_ClientHeartBeat},
+		{CaptureInbound, steamlang.EMsg_ClientAccountInfo},
+	} {
+		p, err := reader.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Direction != expected.direction || p.EMsg != expected.eMsg {
+			t.Fatalf("Expected %v %v, got %v %v", expected.direction, expected.eMsg, p.Direction, p.EMsg)
+		}
+	}
+
+	replay := NewClient()
+	replay.IgnoreEvents()
+	sub = replay.Subscribe(nil)
+	if err := replay.ConnectToReplay(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	defer replay.Disconnect()
+	waitForAccountInfo(t, sub)
+}
+
+func waitForAccountInfo(t *testing.T, sub *Subscription) {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-sub.Events():
+			if info, ok := event.(*AccountInfoEvent); ok {
+				if info.PersonaName != "gopher" {
+					t.Fatalf("Expected persona name gopher, got %v", info.PersonaName)
+				}
+				return
+			}
+		case <-timeout:
+			t.Fatal("Timed out waiting for an AccountInfoEvent")
+		}
+	}
+}
+
+func TestCaptureRedactsLogOn(t *testing.T) {
+	capture := new(bytes.Buffer)
+	client := NewClient()
+	client.SetCapture(NewPacketRecorder(capture))
+
+	buf := new(bytes.Buffer)
+	protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogon, &protobuf.CMsgClientLogon{
+		AccountName:   proto.String("gopher"),
+		Password:      proto.String("hunter2"),
+		AccessToken:   proto.String("token"),
+		TwoFactorCode: proto.String("ABCDE"),
+		ShaSentryfile: []byte{1, 2, 3},
+	}).Serialize(buf)
+	client.captureOutbound(buf.Bytes())
+
+	captured, err := NewPacketReader(capture).Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	packet, err := captured.Packet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := new(protobuf.CMsgClientLogon)
+	packet.ReadProtoMsg(body)
+	if body.GetAccountName() != "gopher" {
+		t.Fatalf("Expected account name gopher, got %q", body.GetAccountName())
+	}
+	if body.Password != nil || body.AccessToken != nil || body.TwoFactorCode != nil || body.ShaSentryfile != nil {
+		t.Fatalf("Expected the secrets to be removed, got %v", body)
+	}
+}
+
+// A buffer that can be written to while it is being read.
+type lockedBuffer struct {
+	mutex sync.Mutex
+	buf   bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) Bytes() []byte {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return append([]byte(nil), b.buf.Bytes()...)
+}
@@ -1,6 +1,8 @@
 package steam
 
 import (
+	"time"
+
 	"github.com/Philipp15b/go-steam/v3/netutil"
 )
 
@@ -10,7 +12,32 @@ type FatalErrorEvent error
 
 type ConnectedEvent struct{}
 
-type DisconnectedEvent struct{}
+type DisconnectedEvent struct {
+	// True if the connection was closed with Client.Disconnect, either by you or because
+	// Steam rejected the logon. False if it was lost, for example because of a network error.
+	UserInitiated bool
+}
+
+// Emitted by the reconnect supervisor before it waits for Delay and then connects to Server.
+type ReconnectingEvent struct {
+	Attempt int
+	Delay   time.Duration
+	Server  string
+}
+
+// Emitted by the reconnect supervisor when the connection has been restored
+// and, unless ReconnectOptions.SkipLogOn is set, the client is logged on again.
+type ReconnectedEvent struct {
+	Attempts int
+	Server   string
+}
+
+// Emitted by the reconnect supervisor when it gives up, either because ReconnectOptions.MaxAttempts
+// was reached or because Steam rejected the logon. Err is the error of the last attempt.
+type ReconnectFailedEvent struct {
+	Attempts int
+	Err      error
+}
 
 // A list of connection manager addresses to connect to in the future.
 // You should always save them and then select one of these
@@ -18,3 +45,10 @@ type DisconnectedEvent struct{}
 type ClientCMListEvent struct {
 	Addresses []*netutil.PortAddr
 }
+
+// Emitted before the client disconnects because the server hasn't sent anything
+// for longer than Client.ConnectionTimeout. A DisconnectedEvent follows.
+type ConnectionTimeoutEvent struct {
+	// The time since the last packet was received.
+	Idle time.Duration
+}
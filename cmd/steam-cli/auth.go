@@ -14,6 +14,7 @@ func handleAuth(args []string) {
 	if len(args) == 0 {
 		fmt.Println("Auth commands:")
 		fmt.Println("  login             - Start authentication")
+		fmt.Println("  login --qr        - Log in with the Steam mobile app, no password needed")
 		fmt.Println("  code              - Submit Steam Guard code")
 		fmt.Println("  logout            - End session")
 		fmt.Println("  status            - Check authentication status")
@@ -56,6 +57,14 @@ func handleAuthLogin(args []string) {
 		return // Exit if rate limited
 	}
 
+	if len(args) > 0 && args[0] == "--qr" {
+		fmt.Println("\n⏳ Starting QR login...")
+		if err := startQRAuthSession(); err != nil {
+			fmt.Printf("❌ Authentication failed: %v\n", err)
+		}
+		return
+	}
+
 	var username, password string
 	
 	// Check for command line arguments first
@@ -95,9 +104,9 @@ func handleAuthLogin(args []string) {
 		return
 	}
 
-	fmt.Println("✅ Authentication session started")
-	fmt.Println("📧 If Steam Guard is required, you'll receive an email")
-	fmt.Println("   Use: steam auth code <CODE>")
+	if status := getAuthStatus(); status.Authenticated {
+		fmt.Println("🔑 Your password isn't stored, later logins use a refresh token")
+	}
 }
 
 func handleAuthCode(args []string) {
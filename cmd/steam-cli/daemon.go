@@ -92,13 +92,13 @@ func runDaemon() {
 	
 	// Load session
 	session := getCurrentSession()
-	if !session.Authenticated {
+	if !session.Authenticated || session.RefreshToken == "" {
 		fmt.Println("No authenticated session found")
 		os.Exit(1)
 	}
 	
 	// Create client
-	client := steam.NewClient()
+	client := newClient()
 	
 	// Start event handler
 	go handleDaemonEvents(client)
@@ -106,11 +106,14 @@ func runDaemon() {
 	// Connect
 	client.Connect()
 	time.Sleep(2 * time.Second)
+
+	// Reconnect and log on again whenever the connection is lost
+	client.EnableAutoReconnect(nil)
 	
 	// Login
 	client.Auth.LogOn(&steam.LogOnDetails{
-		Username: session.Username,
-		Password: session.Password,
+		Username:     session.Username,
+		RefreshToken: session.RefreshToken,
 	})
 	
 	// Update daemon state periodically
@@ -121,7 +124,7 @@ func runDaemon() {
 		select {
 		case <-sigChan:
 			fmt.Println("Daemon shutting down...")
-			client.Disconnect()
+			client.Auth.LogOff()
 			cleanupDaemon()
 			os.Exit(0)
 			
@@ -179,9 +182,16 @@ func handleDaemonEvents(client *steam.Client) {
 			fmt.Println("🐛 DEBUG: Offline messages requested - should enable incoming message packets")
 			
 		case *steam.DisconnectedEvent:
-			fmt.Println("Daemon disconnected, reconnecting...")
-			time.Sleep(5 * time.Second)
-			client.Connect()
+			fmt.Println("Daemon disconnected")
+
+		case *steam.ReconnectingEvent:
+			fmt.Printf("Daemon reconnecting to %s in %v (attempt %d)\n", e.Server, e.Delay.Round(time.Millisecond), e.Attempt)
+
+		case *steam.ReconnectedEvent:
+			fmt.Println("Daemon reconnected")
+
+		case *steam.ReconnectFailedEvent:
+			fmt.Printf("Daemon could not reconnect: %v\n", e.Err)
 			
 		case *steam.LogOnFailedEvent:
 			fmt.Printf("Daemon login failed: %v\n", e.Result)
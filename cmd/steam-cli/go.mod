@@ -6,12 +6,15 @@ toolchain go1.24.5
 
 require (
 	github.com/Philipp15b/go-steam/v3 v3.0.0
+	github.com/mdp/qrterminal/v3 v3.2.0
 	golang.org/x/term v0.34.0
 )
 
 require (
+	github.com/gorilla/websocket v1.5.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
 	google.golang.org/protobuf v1.27.1 // indirect
+	rsc.io/qr v0.2.0 // indirect
 )
 
 replace github.com/Philipp15b/go-steam/v3 => ../../
@@ -2,7 +2,10 @@ package main
 
 import (
 	"fmt"
+	"log"
 	"os"
+
+	steam "github.com/Philipp15b/go-steam/v3"
 )
 
 func main() {
@@ -42,6 +45,7 @@ func printUsage() {
 	fmt.Println()
 	fmt.Println("Auth Commands:")
 	fmt.Println("  steam auth login                    # Start authentication")
+	fmt.Println("  steam auth login --qr               # Log in by scanning a QR code in the Steam app")
 	fmt.Println("  steam auth code <CODE>              # Submit Steam Guard code")
 	fmt.Println("  steam auth logout                   # End session")
 	fmt.Println("  steam auth status                   # Check authentication status")
@@ -66,6 +70,20 @@ func printUsage() {
 	fmt.Println("  steam friends list")
 	fmt.Println()
 	fmt.Println("State is maintained in ~/.steam-cli/")
+	fmt.Println("Set STEAM_CLI_DEBUG=1 to log what the client does, or STEAM_CLI_DEBUG=packets to trace every packet.")
+}
+
+// Creates a client that logs to stderr if STEAM_CLI_DEBUG is set.
+func newClient() *steam.Client {
+	client := steam.NewClient()
+	switch os.Getenv("STEAM_CLI_DEBUG") {
+	case "":
+		return client
+	case "packets":
+		client.SetLogSubsystems(steam.LogAll)
+	}
+	client.SetLogger(steam.NewStdLogger(log.New(os.Stderr, "", log.LstdFlags), "debug"))
+	return client
 }
 
 func handleDaemon(args []string) {
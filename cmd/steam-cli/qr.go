@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mdp/qrterminal/v3"
+)
+
+// Prints the challenge URL of a QR login as a QR code that fits into a terminal
+func printQRCode(url string) {
+	fmt.Println()
+	qrterminal.GenerateHalfBlock(url, qrterminal.L, os.Stdout)
+	fmt.Printf("   %s\n", url)
+	fmt.Println("⏳ Waiting for approval in the Steam mobile app...")
+}
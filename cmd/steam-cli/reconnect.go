@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -27,50 +28,53 @@ func ensureConnection() error {
 	fmt.Println("🔄 Reconnecting to Steam...")
 
 	session := getCurrentSession()
-	if !session.Authenticated || session.Username == "" {
+	if !session.Authenticated || session.RefreshToken == "" {
 		return fmt.Errorf("not authenticated - use 'steam auth login' first")
 	}
 
 	// Clean up any existing session
 	if globalClient != nil {
+		globalClient.DisableAutoReconnect()
 		globalClient.Disconnect()
 	}
 
-	// Create new client
-	globalClient = steam.NewClient()
+	if err := connectAndLogOn(session, 10*time.Second); err != nil {
+		return fmt.Errorf("failed to reconnect: %v", err)
+	}
+	fmt.Println("✅ Reconnected successfully")
+	return nil
+}
 
-	// Skip auto-login in event handler 
+// Creates a new client, logs on with the stored credentials and keeps the
+// connection alive with the reconnect supervisor.
+func connectAndLogOn(session *SessionState, timeout time.Duration) error {
+	globalClient = newClient()
+
+	// Skip auto-login in event handler
 	skipAutoLogin = true
+	defer func() { skipAutoLogin = false }()
 
 	// Start simplified event handling for reconnection
 	go handleReconnectEvents()
 
-	// Connect
-	globalClient.Connect()
-
-	// Wait for connection
-	time.Sleep(3 * time.Second)
-
-	// Log back in with stored credentials
-	globalClient.Auth.LogOn(&steam.LogOnDetails{
-		Username: session.Username,
-		Password: session.Password,
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if _, err := globalClient.ConnectContext(ctx); err != nil {
+		return err
+	}
+	err := globalClient.Auth.LogOnContext(ctx, &steam.LogOnDetails{
+		Username:     session.Username,
+		RefreshToken: session.RefreshToken,
 	})
-
-	// Wait for authentication
-	time.Sleep(5 * time.Second)
-
-	// Re-enable auto-login
-	skipAutoLogin = false
-
-	// Set online status
-	if globalClient.SteamId() != 0 {
-		globalClient.Social.SetPersonaState(steamlang.EPersonaState_Online)
-		fmt.Println("✅ Reconnected successfully")
-		return nil
+	if err != nil {
+		globalClient.Disconnect()
+		return err
 	}
+	globalClient.EnableAutoReconnect(nil)
 
-	return fmt.Errorf("failed to reconnect")
+	// Set online status
+	globalClient.Social.SetPersonaState(steamlang.EPersonaState_Online)
+	return nil
 }
 
 func handleReconnectEvents() {
@@ -92,7 +96,16 @@ func handleReconnectEvents() {
 			
 		case *steam.DisconnectedEvent:
 			// Handle disconnection
-			
+
+		case *steam.ReconnectingEvent:
+			fmt.Printf("🔄 Connection lost, reconnecting to %s in %v (attempt %d)\n", e.Server, e.Delay.Round(time.Millisecond), e.Attempt)
+
+		case *steam.ReconnectedEvent:
+			fmt.Println("✅ Reconnected to Steam")
+
+		case *steam.ReconnectFailedEvent:
+			fmt.Printf("❌ Giving up reconnecting after %d attempts: %v\n", e.Attempts, e.Err)
+
 		case *steam.ChatMsgEvent:
 			fmt.Printf("📨 Message from %d: %s\n", e.ChatterId, e.Message)
 		}
@@ -118,26 +131,9 @@ func connectToDaemon() error {
 	}
 	
 	// Create client (this would ideally connect to daemon's client)
-	globalClient = steam.NewClient()
-	
-	// Quick connection for daemon mode
-	go handleReconnectEvents()
-	globalClient.Connect()
-	time.Sleep(1 * time.Second)
-	
-	globalClient.Auth.LogOn(&steam.LogOnDetails{
-		Username: session.Username,
-		Password: session.Password,
-	})
-	
-	time.Sleep(3 * time.Second)
-	
-	if globalClient.SteamId() != 0 {
-		// Ensure we're visible to friends by setting online status
-		globalClient.Social.SetPersonaState(steamlang.EPersonaState_Online)
-		fmt.Println("✅ Connected via daemon - set to online")
-		return nil
+	if err := connectAndLogOn(session, 5*time.Second); err != nil {
+		return fmt.Errorf("daemon connection failed: %v", err)
 	}
-	
-	return fmt.Errorf("daemon connection failed")
-}
\ No newline at end of file
+	fmt.Println("✅ Connected via daemon - set to online")
+	return nil
+}
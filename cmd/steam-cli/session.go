@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,19 +11,32 @@ import (
 	"time"
 
 	steam "github.com/Philipp15b/go-steam/v3"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf/unified"
 	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamid"
 )
 
 // Session state that persists between commands
 type SessionState struct {
-	Username     string    `json:"username"`
-	Password     string    `json:"password"` // Temporarily store for Steam Guard flow
-	Connected    bool      `json:"connected"`
-	Authenticated bool     `json:"authenticated"`
-	NeedsCode    bool      `json:"needs_code"`
-	SteamID      uint64    `json:"steam_id"`
-	LastError    string    `json:"last_error"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	Username      string       `json:"username"`
+	RefreshToken  string       `json:"refresh_token,omitempty"` // Used instead of the password for every logon
+	GuardData     string       `json:"guard_data,omitempty"`    // Lets Steam skip email codes on this machine
+	PendingAuth   *PendingAuth `json:"pending_auth,omitempty"`  // Auth session waiting for a Steam Guard code
+	Connected     bool         `json:"connected"`
+	Authenticated bool         `json:"authenticated"`
+	NeedsCode     bool         `json:"needs_code"`
+	SteamID       uint64       `json:"steam_id"`
+	LastError     string       `json:"last_error"`
+	UpdatedAt     time.Time    `json:"updated_at"`
+}
+
+// An auth session that was started by 'steam auth login' and is finished by 'steam auth code'.
+// Steam keeps it for a few minutes, so it doesn't depend on the connection.
+type PendingAuth struct {
+	ClientID  uint64 `json:"client_id"`
+	RequestID []byte `json:"request_id"`
+	SteamID   uint64 `json:"steam_id"`
+	CodeType  int32  `json:"code_type"`
 }
 
 // AuthStatus for displaying current status
@@ -35,9 +50,9 @@ type AuthStatus struct {
 }
 
 var (
-	globalClient *steam.Client
-	clientMutex  sync.Mutex
-	sessionFile  string
+	globalClient  *steam.Client
+	clientMutex   sync.Mutex
+	sessionFile   string
 	skipAutoLogin bool // Flag to prevent auto-login in event handler
 )
 
@@ -47,53 +62,181 @@ func init() {
 	if err != nil {
 		panic(fmt.Sprintf("Cannot get home directory: %v", err))
 	}
-	
+
 	configDir := filepath.Join(homeDir, ".steam-cli")
 	os.MkdirAll(configDir, 0700)
-	
+
 	sessionFile = filepath.Join(configDir, "session.json")
 }
 
-func startAuthSession(username, password string) error {
-	clientMutex.Lock()
-	defer clientMutex.Unlock()
-
+// Creates a new client and connects it. We log on ourselves, so the event handler doesn't.
+// Must be called with clientMutex held; the returned function re-enables auto-login.
+func connectForAuth(ctx context.Context) (func(), error) {
 	// Clean up any existing session
 	if globalClient != nil {
 		globalClient.Disconnect()
 	}
 
 	// Create new client
-	globalClient = steam.NewClient()
-	
-	// Store password temporarily for auth (not persisted)
-	tempPassword = password
-	
-	// Save initial state (including password for Steam Guard flow)
-	state := &SessionState{
-		Username:      username,
-		Password:      password, // Store temporarily for Steam Guard
-		Connected:     false,
-		Authenticated: false,
-		NeedsCode:     false,
-		UpdatedAt:     time.Now(),
-	}
-	saveSessionState(state)
-	
+	globalClient = newClient()
+
+	skipAutoLogin = true
+	done := func() { skipAutoLogin = false }
+
 	// Start event handling in background
 	go handleSteamEvents()
 
-	// Connect and wait briefly for initial connection
-	globalClient.Connect()
-	
-	// Wait up to 10 seconds for connection events
 	fmt.Println("⏳ Connecting to Steam...")
-	time.Sleep(10 * time.Second)
-	
+	if _, err := globalClient.ConnectContext(ctx); err != nil {
+		done()
+		return nil, fmt.Errorf("failed to connect: %v", err)
+	}
+	return done, nil
+}
+
+func startAuthSession(username, password string) error {
+	clientMutex.Lock()
+	defer clientMutex.Unlock()
+
+	// Save initial state - the password is never stored
+	previous := getCurrentSession()
+	state := &SessionState{
+		Username:  username,
+		GuardData: previous.GuardData,
+	}
+	saveSessionState(state)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	done, err := connectForAuth(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	session, err := globalClient.Auth.BeginAuthSessionViaCredentials(ctx, &steam.CredentialsDetails{
+		Username:  username,
+		Password:  password,
+		GuardData: state.GuardData,
+	})
+	if err != nil {
+		return reportAuthError(err)
+	}
+
+	if codeType := steamGuardCodeType(session); codeType != unified.EAuthSessionGuardType_k_EAuthSessionGuardType_Unknown {
+		updateSessionState(func(state *SessionState) {
+			state.NeedsCode = true
+			state.PendingAuth = &PendingAuth{
+				ClientID:  session.ClientId,
+				RequestID: session.RequestId,
+				SteamID:   uint64(session.SteamId),
+				CodeType:  int32(codeType),
+			}
+		})
+		if codeType == unified.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode {
+			fmt.Println("📧 Steam sent a Steam Guard code to your email")
+		} else {
+			fmt.Println("📱 Enter the code from the Steam mobile app")
+		}
+		fmt.Println("   Use: steam auth code <CODE>")
+		return nil
+	}
+
+	return finishAuthSession(ctx, session, username)
+}
+
+// Logs on by scanning a QR code with the Steam mobile app, so no password is needed at all.
+func startQRAuthSession() error {
+	clientMutex.Lock()
+	defer clientMutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	done, err := connectForAuth(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	fmt.Println("📱 Scan this QR code with the Steam mobile app:")
+	tokens, err := globalClient.Auth.LogOnWithQR(ctx, func(url string) {
+		printQRCode(url)
+	})
+	if err != nil {
+		return reportAuthError(err)
+	}
+	saveTokens(tokens)
+	return nil
+}
+
+// Returns the kind of Steam Guard code the session needs, or Unknown if it can be finished without one.
+func steamGuardCodeType(session *steam.AuthSession) unified.EAuthSessionGuardType {
+	for _, c := range session.AllowedConfirmations {
+		switch t := c.GetConfirmationType(); t {
+		case unified.EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode,
+			unified.EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode:
+			return t
+		}
+	}
+	return unified.EAuthSessionGuardType_k_EAuthSessionGuardType_Unknown
+}
+
+// Waits for the session to be confirmed, stores the refresh token and logs on with it.
+func finishAuthSession(ctx context.Context, session *steam.AuthSession, username string) error {
+	tokens, err := session.Wait(ctx)
+	if err != nil {
+		return reportAuthError(err)
+	}
+	if tokens.AccountName == "" {
+		tokens.AccountName = username
+	}
+	saveTokens(tokens)
+
+	return logOnAndWait(ctx, &steam.LogOnDetails{
+		Username:     tokens.AccountName,
+		RefreshToken: tokens.RefreshToken,
+	})
+}
+
+func saveTokens(tokens *steam.AuthTokens) {
+	updateSessionState(func(state *SessionState) {
+		state.Username = tokens.AccountName
+		state.RefreshToken = tokens.RefreshToken
+		if tokens.NewGuardData != "" {
+			state.GuardData = tokens.NewGuardData
+		}
+		state.PendingAuth = nil
+		state.NeedsCode = false
+	})
+}
+
+// Reports errors of the auth session like the event handler reports rejected logons.
+func reportAuthError(err error) error {
+	var resultErr *steam.EResultError
+	if !errors.As(err, &resultErr) {
+		return err
+	}
+	recordAuthAttempt(resultErr.Result)
+	fmt.Printf("❌ %s\n", analyzeAuthError(resultErr.Result))
+	updateSessionState(func(state *SessionState) {
+		state.Authenticated = false
+		state.LastError = fmt.Sprintf("Authentication failed: %v", resultErr.Result)
+	})
 	return nil
 }
 
-var tempPassword string // Temporary storage, not persisted
+// Logs on and blocks until Steam has answered. Rejected logons are reported
+// by the event handler, so they are not returned as an error.
+func logOnAndWait(ctx context.Context, details *steam.LogOnDetails) error {
+	err := globalClient.Auth.LogOnContext(ctx, details)
+	var resultErr *steam.EResultError
+	if errors.As(err, &resultErr) {
+		return nil
+	}
+	return err
+}
 
 func handleSteamEvents() {
 	for event := range globalClient.Events() {
@@ -104,20 +247,19 @@ func handleSteamEvents() {
 				state.Connected = true
 				state.LastError = ""
 			})
-			
+
 			// Only attempt auto-login if not skipping
-			if !skipAutoLogin {
-				session := getCurrentSession()
+			if session := getCurrentSession(); !skipAutoLogin && session.RefreshToken != "" {
 				globalClient.Auth.LogOn(&steam.LogOnDetails{
-					Username: session.Username,
-					Password: session.Password,
+					Username:     session.Username,
+					RefreshToken: session.RefreshToken,
 				})
 			}
 
 		case *steam.LoggedOnEvent:
 			// Record successful auth
 			recordAuthAttempt(steamlang.EResult_OK)
-			
+
 			fmt.Println("✅ Authentication successful!")
 			updateSessionState(func(state *SessionState) {
 				state.Authenticated = true
@@ -125,36 +267,30 @@ func handleSteamEvents() {
 				state.SteamID = uint64(globalClient.SteamId())
 				state.LastError = ""
 			})
-			
-			// Clear temp password
-			tempPassword = ""
-			
+
 			// Set online status
 			globalClient.Social.SetPersonaState(steamlang.EPersonaState_Online)
 
 		case *steam.LogOnFailedEvent:
 			// Record the auth attempt for rate limiting
 			recordAuthAttempt(e.Result)
-			
+
 			errorMsg := analyzeAuthError(e.Result)
 			fmt.Printf("❌ %s\n", errorMsg)
-			
+
 			updateSessionState(func(state *SessionState) {
 				state.Authenticated = false
 				state.LastError = fmt.Sprintf("Authentication failed: %v", e.Result)
-				
+
 				switch e.Result {
-				case steamlang.EResult_AccountLogonDenied:
-					state.NeedsCode = true
-					fmt.Println("📧 Use: steam auth code <CODE>")
-					
-				case steamlang.EResult_InvalidLoginAuthCode:
-					state.NeedsCode = true
-					fmt.Println("📧 Use: steam auth code <NEW_CODE>")
-					
+				case steamlang.EResult_AccessDenied, steamlang.EResult_Expired:
+					// the refresh token was revoked or has expired
+					state.RefreshToken = ""
+					fmt.Println("🔑 Use: steam auth login")
+
 				case steamlang.EResult_RateLimitExceeded:
 					fmt.Println("🚫 Account temporarily blocked - wait 15+ minutes")
-					
+
 				default:
 					// For other errors, don't prompt for codes
 				}
@@ -166,14 +302,6 @@ func handleSteamEvents() {
 				state.Connected = false
 				state.Authenticated = false
 			})
-			
-			// Auto-reconnect if we're waiting for a Steam Guard code
-			session := getCurrentSession()
-			if session.NeedsCode && !session.Authenticated {
-				fmt.Println("🔄 Reconnecting for Steam Guard submission...")
-				time.Sleep(2 * time.Second)
-				globalClient.Connect()
-			}
 
 		case *steam.ChatMsgEvent:
 			fmt.Printf("📨 Message from %d: %s\n", e.ChatterId, e.Message)
@@ -183,57 +311,33 @@ func handleSteamEvents() {
 
 func submitSteamGuardCode(code string) error {
 	session := getCurrentSession()
-	if !session.NeedsCode {
+	pending := session.PendingAuth
+	if !session.NeedsCode || pending == nil {
 		return fmt.Errorf("Steam Guard code not currently needed")
 	}
 
-	if session.Username == "" {
-		return fmt.Errorf("no username stored - use 'steam auth login' first")
-	}
-
 	fmt.Println("🔄 Reconnecting with Steam Guard code...")
-	
+
 	clientMutex.Lock()
 	defer clientMutex.Unlock()
 
-	// Clean up any existing session
-	if globalClient != nil {
-		globalClient.Disconnect()
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
 
-	// Create new client
-	globalClient = steam.NewClient()
-	
-	// Skip auto-login in event handler
-	skipAutoLogin = true
-	
-	// Start event handling in background
-	go handleSteamEvents()
-
-	// Connect
-	globalClient.Connect()
-	
-	// Wait for connection, then submit with code
-	time.Sleep(3 * time.Second)
-	
-	// Attempt login with code
-	globalClient.Auth.LogOn(&steam.LogOnDetails{
-		Username: session.Username,
-		Password: session.Password, // Use stored password
-		AuthCode: code,
-	})
-	
-	// Re-enable auto-login for future
-	skipAutoLogin = false
+	done, err := connectForAuth(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
 
-	updateSessionState(func(state *SessionState) {
-		state.NeedsCode = false
-	})
-	
-	// Wait for auth result
-	time.Sleep(5 * time.Second)
+	// Continue the session that 'steam auth login' started
+	authSession := globalClient.Auth.ResumeAuthSession(pending.ClientID, pending.RequestID, steamid.SteamId(pending.SteamID))
+	err = authSession.SubmitSteamGuardCode(ctx, code, unified.EAuthSessionGuardType(pending.CodeType))
+	if err != nil {
+		return reportAuthError(err)
+	}
 
-	return nil
+	return finishAuthSession(ctx, authSession, session.Username)
 }
 
 func endAuthSession() error {
@@ -241,7 +345,7 @@ func endAuthSession() error {
 	defer clientMutex.Unlock()
 
 	if globalClient != nil {
-		globalClient.Disconnect()
+		globalClient.Auth.LogOff()
 		globalClient = nil
 	}
 
@@ -294,4 +398,4 @@ func updateSessionState(updateFunc func(*SessionState)) error {
 	state := getCurrentSession()
 	updateFunc(state)
 	return saveSessionState(state)
-}
\ No newline at end of file
+}
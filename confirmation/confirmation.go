@@ -0,0 +1,260 @@
+/*
+Package confirmation lists, accepts and cancels the mobile confirmations of an account, such as
+trade offers and market listings that wait for the Steam Guard mobile authenticator.
+
+Requests are authorized with keys generated from the authenticator's identity secret, and made
+with the web session cookies of steam.Web:
+
+	c := confirmation.NewClient(client.SteamId(), identitySecret,
+		client.Web.SessionId, client.Web.SteamLogin, client.Web.SteamLoginSecure)
+	confs, err := c.List()
+	if err != nil {
+		return err
+	}
+	err = c.AcceptAll(confs)
+*/
+package confirmation
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/community"
+	"github.com/Philipp15b/go-steam/v3/netutil"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+	"github.com/Philipp15b/go-steam/v3/totp"
+)
+
+const mobileConfUrl = "https://steamcommunity.com/mobileconf/"
+
+// Returned if Steam doesn't accept the web session, for example because it has expired.
+var ErrNeedsAuth = errors.New("confirmation: web session is not logged on")
+
+// Returned if Steam answers a request with success set to false.
+var ErrFailed = errors.New("confirmation: steam returned no success")
+
+// The kind of a confirmation.
+type Type int
+
+const (
+	TypeGeneric           Type = 1
+	TypeTrade             Type = 2
+	TypeMarketListing     Type = 3
+	TypePhoneNumberChange Type = 5
+	TypeAccountRecovery   Type = 6
+)
+
+// A pending confirmation.
+type Confirmation struct {
+	Type     Type   `json:"type"`
+	TypeName string `json:"type_name"`
+	Id       uint64 `json:"id,string"`
+	// The ID of the object that needs the confirmation, for example the trade offer ID or the market listing ID.
+	CreatorId uint64 `json:"creator_id,string"`
+	// The key that authorizes accepting or cancelling the confirmation.
+	Nonce        uint64   `json:"nonce,string"`
+	CreationTime int64    `json:"creation_time"`
+	Icon         string   `json:"icon"`
+	Headline     string   `json:"headline"`
+	Summary      []string `json:"summary"`
+}
+
+// Returns the device ID the mobile authenticator of the account uses by default, which
+// is derived from the Steam ID like other authenticator implementations do.
+func DeviceId(steamId steamid.SteamId) string {
+	hash := sha1.Sum([]byte(strconv.FormatUint(uint64(steamId), 10)))
+	h := hex.EncodeToString(hash[:])
+	return "android:" + h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
+
+type Client struct {
+	client         *http.Client
+	steamId        steamid.SteamId
+	identitySecret string
+	baseUrl        string
+
+	// The device ID of the mobile authenticator. Defaults to DeviceId(steamId); set it to the
+	// device_id of an imported authenticator if it differs.
+	DeviceId string
+	// If set, the keys are generated for Steam's time instead of the local time.
+	TimeSync *totp.TimeSync
+}
+
+func NewClient(steamId steamid.SteamId, identitySecret, sessionId, steamLogin, steamLoginSecure string) *Client {
+	return NewClientWithHTTPClient(new(http.Client), steamId, identitySecret, sessionId, steamLogin, steamLoginSecure)
+}
+
+// Creates a client that makes its requests with a copy of the given HTTP client, for example
+// one that goes through a proxy. The cookies are set on the copy, so the client can be shared.
+func NewClientWithHTTPClient(httpClient *http.Client, steamId steamid.SteamId, identitySecret, sessionId, steamLogin, steamLoginSecure string) *Client {
+	client := *httpClient
+	client.Jar = nil
+	community.SetCookies(&client, sessionId, steamLogin, steamLoginSecure)
+	return &Client{
+		client:         &client,
+		steamId:        steamId,
+		identitySecret: identitySecret,
+		baseUrl:        mobileConfUrl,
+		DeviceId:       DeviceId(steamId),
+	}
+}
+
+// Returns the pending confirmations.
+func (c *Client) List() ([]*Confirmation, error) {
+	params, err := c.params("conf")
+	if err != nil {
+		return nil, err
+	}
+	resp := new(struct {
+		Conf []*Confirmation
+	})
+	if err = c.get("getlist", params, resp); err != nil {
+		return nil, err
+	}
+	return resp.Conf, nil
+}
+
+// Returns the pending confirmation of the trade offer, or nil if there is none.
+func (c *Client) FindTradeOffer(offerId uint64) (*Confirmation, error) {
+	confs, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, conf := range confs {
+		if conf.Type == TypeTrade && conf.CreatorId == offerId {
+			return conf, nil
+		}
+	}
+	return nil, nil
+}
+
+// Returns the HTML that describes the confirmation in the mobile app.
+func (c *Client) Details(conf *Confirmation) (string, error) {
+	params, err := c.params("details")
+	if err != nil {
+		return "", err
+	}
+	resp := new(struct {
+		Html string
+	})
+	if err = c.get(fmt.Sprintf("details/%d", conf.Id), params, resp); err != nil {
+		return "", err
+	}
+	return resp.Html, nil
+}
+
+// Accepts the confirmation.
+func (c *Client) Accept(conf *Confirmation) error {
+	return c.respond("allow", conf)
+}
+
+// Cancels the confirmation.
+func (c *Client) Cancel(conf *Confirmation) error {
+	return c.respond("cancel", conf)
+}
+
+// Accepts all of the confirmations with one request.
+func (c *Client) AcceptAll(confs []*Confirmation) error {
+	return c.respondAll("allow", confs)
+}
+
+// Cancels all of the confirmations with one request.
+func (c *Client) CancelAll(confs []*Confirmation) error {
+	return c.respondAll("cancel", confs)
+}
+
+func (c *Client) respond(op string, conf *Confirmation) error {
+	params, err := c.params(op)
+	if err != nil {
+		return err
+	}
+	params.Set("op", op)
+	params.Set("cid", strconv.FormatUint(conf.Id, 10))
+	params.Set("ck", strconv.FormatUint(conf.Nonce, 10))
+	return c.get("ajaxop", params, nil)
+}
+
+func (c *Client) respondAll(op string, confs []*Confirmation) error {
+	if len(confs) == 0 {
+		return nil
+	}
+	params, err := c.params(op)
+	if err != nil {
+		return err
+	}
+	params.Set("op", op)
+	for _, conf := range confs {
+		params.Add("cid[]", strconv.FormatUint(conf.Id, 10))
+		params.Add("ck[]", strconv.FormatUint(conf.Nonce, 10))
+	}
+	resp, err := c.client.Do(netutil.NewPostForm(c.baseUrl+"multiajaxop", params))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decodeResponse(resp, nil)
+}
+
+// Returns the parameters that authorize a request with the given tag.
+func (c *Client) params(tag string) (url.Values, error) {
+	now := time.Now()
+	if c.TimeSync != nil {
+		now = c.TimeSync.Now()
+	}
+	key, err := totp.GenerateConfirmationKey(c.identitySecret, now, tag)
+	if err != nil {
+		return nil, err
+	}
+	return url.Values{
+		"p":   {c.DeviceId},
+		"a":   {strconv.FormatUint(uint64(c.steamId), 10)},
+		"k":   {key},
+		"t":   {strconv.FormatInt(now.Unix(), 10)},
+		"m":   {"react"},
+		"tag": {tag},
+	}, nil
+}
+
+func (c *Client) get(path string, params url.Values, result interface{}) error {
+	resp, err := c.client.Get(c.baseUrl + path + "?" + params.Encode())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decodeResponse(resp, result)
+}
+
+// Checks the success of a response and decodes it into result, if it isn't nil.
+func decodeResponse(resp *http.Response, result interface{}) error {
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("confirmation: status code %d", resp.StatusCode)
+	}
+	var raw json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return err
+	}
+	status := new(struct {
+		Success  bool
+		NeedAuth bool `json:"needauth"`
+	})
+	if err := json.Unmarshal(raw, status); err != nil {
+		return err
+	}
+	if status.NeedAuth {
+		return ErrNeedsAuth
+	}
+	if !status.Success {
+		return ErrFailed
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, result)
+}
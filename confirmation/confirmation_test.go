@@ -0,0 +1,96 @@
+package confirmation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/steamid"
+	"github.com/Philipp15b/go-steam/v3/totp"
+)
+
+const testIdentitySecret = "AAAAAAAAAAAAAAAAAAAAAAAAAAA="
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	c := NewClient(steamid.SteamId(76561197960287930), testIdentitySecret, "session", "login", "secure")
+	c.baseUrl = server.URL + "/mobileconf/"
+	return c
+}
+
+// Checks the key of a request against the time and tag it was sent with.
+func checkKey(t *testing.T, r *http.Request, tag string) {
+	r.ParseForm()
+	q := r.Form
+	if q.Get("tag") != tag || q.Get("a") != "76561197960287930" || q.Get("m") != "react" {
+		t.Errorf("unexpected parameters: %v", q)
+	}
+	if q.Get("p") != DeviceId(76561197960287930) {
+		t.Errorf("unexpected device ID %q", q.Get("p"))
+	}
+	sent, _ := strconv.ParseInt(q.Get("t"), 10, 64)
+	key, _ := totp.GenerateConfirmationKey(testIdentitySecret, time.Unix(sent, 0), tag)
+	if q.Get("k") != key {
+		t.Errorf("expected key %q, got %q", key, q.Get("k"))
+	}
+}
+
+func TestListAndAccept(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mobileconf/getlist":
+			checkKey(t, r, "conf")
+			w.Write([]byte(`{"success":true,"conf":[
+				{"type":2,"type_name":"Trade Offer","id":"13036733154","creator_id":"5950486466","nonce":"5829906440858617006","creation_time":1685025431,"headline":"friend","summary":["You will give up 1 item"]},
+				{"type":3,"type_name":"Market Listing","id":"13036733155","creator_id":"42","nonce":"1","creation_time":1685025432}]}`))
+		case "/mobileconf/multiajaxop":
+			checkKey(t, r, "allow")
+			if r.PostForm.Get("op") != "allow" || len(r.PostForm["cid[]"]) != 2 || r.PostForm["ck[]"][0] != "5829906440858617006" {
+				t.Errorf("unexpected form: %v", r.PostForm)
+			}
+			w.Write([]byte(`{"success":true}`))
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+
+	conf, err := c.FindTradeOffer(5950486466)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conf == nil || conf.Id != 13036733154 || conf.Headline != "friend" || len(conf.Summary) != 1 {
+		t.Fatalf("unexpected confirmation %+v", conf)
+	}
+	confs, err := c.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(confs) != 2 || confs[1].Type != TypeMarketListing {
+		t.Fatalf("unexpected confirmations %+v", confs)
+	}
+	if err = c.AcceptAll(confs); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNeedsAuth(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":false,"needauth":true}`))
+	})
+	if _, err := c.List(); err != ErrNeedsAuth {
+		t.Fatalf("expected ErrNeedsAuth, got %v", err)
+	}
+}
+
+func TestConfirmationKey(t *testing.T) {
+	key, err := totp.GenerateConfirmationKey(testIdentitySecret, time.Unix(1685025431, 0), "conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "fmfxb/8zcPpty93i90hk/fKgoIY=" {
+		t.Fatalf("unexpected key %q", key)
+	}
+}
@@ -1,6 +1,7 @@
 package steam
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"encoding/binary"
@@ -10,6 +11,7 @@ import (
 	"sync"
 
 	"github.com/Philipp15b/go-steam/v3/cryptoutil"
+	"github.com/Philipp15b/go-steam/v3/netutil"
 	"github.com/Philipp15b/go-steam/v3/protocol"
 )
 
@@ -17,20 +19,22 @@ type connection interface {
 	Read() (*protocol.Packet, error)
 	Write([]byte) error
 	Close() error
-	SetEncryptionKey([]byte)
+	// Sets the session key. If hmacSecret is not nil, the IVs are derived and verified with HMAC-SHA1.
+	SetEncryptionKey(key, hmacSecret []byte)
 	IsEncrypted() bool
 }
 
 const tcpConnectionMagic uint32 = 0x31305456 // "VT01"
 
 type tcpConnection struct {
-	conn        *net.TCPConn
+	conn        net.Conn
 	ciph        cipher.Block
+	hmacSecret  []byte
 	cipherMutex sync.RWMutex
 }
 
-func dialTCP(laddr, raddr *net.TCPAddr) (*tcpConnection, error) {
-	conn, err := net.DialTCP("tcp", laddr, raddr)
+func dialTCP(ctx context.Context, dialer netutil.Dialer, addr string) (*tcpConnection, error) {
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
 	if err != nil {
 		return nil, err
 	}
@@ -69,10 +73,15 @@ func (c *tcpConnection) Read() (*protocol.Packet, error) {
 
 	// Packets after ChannelEncryptResult are encrypted
 	c.cipherMutex.RLock()
-	if c.ciph != nil {
+	if c.ciph != nil && c.hmacSecret != nil {
+		buf, err = cryptoutil.SymmetricDecryptWithHMACIV(c.ciph, buf, c.hmacSecret)
+	} else if c.ciph != nil {
 		buf = cryptoutil.SymmetricDecrypt(c.ciph, buf)
 	}
 	c.cipherMutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
 
 	return protocol.NewPacket(buf)
 }
@@ -80,7 +89,9 @@ func (c *tcpConnection) Read() (*protocol.Packet, error) {
 // Writes a message. This may only be used by one goroutine at a time.
 func (c *tcpConnection) Write(message []byte) error {
 	c.cipherMutex.RLock()
-	if c.ciph != nil {
+	if c.ciph != nil && c.hmacSecret != nil {
+		message = cryptoutil.SymmetricEncryptWithHMACIV(c.ciph, message, c.hmacSecret)
+	} else if c.ciph != nil {
 		message = cryptoutil.SymmetricEncrypt(c.ciph, message)
 	}
 	c.cipherMutex.RUnlock()
@@ -102,9 +113,10 @@ func (c *tcpConnection) Close() error {
 	return c.conn.Close()
 }
 
-func (c *tcpConnection) SetEncryptionKey(key []byte) {
+func (c *tcpConnection) SetEncryptionKey(key, hmacSecret []byte) {
 	c.cipherMutex.Lock()
 	defer c.cipherMutex.Unlock()
+	c.hmacSecret = hmacSecret
 	if key == nil {
 		c.ciph = nil
 		return
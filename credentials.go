@@ -0,0 +1,259 @@
+package steam
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Philipp15b/go-steam/v3/cryptoutil"
+)
+
+// The secrets of an account that are kept between logons, see CredentialStore.
+type Credentials struct {
+	// The hash of the sentry file of the last MachineAuthUpdateEvent.
+	SentryHash SentryHash `json:",omitempty"`
+	// The login key of the last LoginKeyEvent, if ShouldRememberPassword was set.
+	LoginKey string `json:",omitempty"`
+	// The refresh token of the last authentication session.
+	RefreshToken string `json:",omitempty"`
+	// The guard data of the last authentication session, see CredentialsDetails.GuardData.
+	GuardData string `json:",omitempty"`
+	// Identifies this machine to Steam. It is generated on the first logon.
+	MachineId []byte `json:",omitempty"`
+}
+
+// Keeps the credentials of accounts between runs, see Client.CredentialStore.
+// Implementations must be safe for concurrent use, since one store can be shared by many clients.
+type CredentialStore interface {
+	// Returns the credentials of the account, or nil if there are none.
+	Load(account string) (*Credentials, error)
+	// Replaces the credentials of the account.
+	Save(account string, credentials *Credentials) error
+}
+
+// Returns a store that keeps the credentials in memory only.
+func NewMemoryCredentialStore() CredentialStore {
+	return &memoryCredentialStore{accounts: make(map[string]Credentials)}
+}
+
+type memoryCredentialStore struct {
+	mutex    sync.Mutex
+	accounts map[string]Credentials
+}
+
+func (s *memoryCredentialStore) Load(account string) (*Credentials, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c, ok := s.accounts[account]; ok {
+		return &c, nil
+	}
+	return nil, nil
+}
+
+func (s *memoryCredentialStore) Save(account string, credentials *Credentials) error {
+	s.mutex.Lock()
+	s.accounts[account] = *credentials
+	s.mutex.Unlock()
+	return nil
+}
+
+// Returns a store that keeps the credentials of all accounts in one JSON file, which only
+// the current user can read. The secrets are stored in plain text; see NewEncryptedFileCredentialStore.
+func NewFileCredentialStore(path string) CredentialStore {
+	return &fileCredentialStore{path: path}
+}
+
+// Returns a store like NewFileCredentialStore that encrypts the file with AES-GCM
+// and a key derived from the passphrase with PBKDF2.
+func NewEncryptedFileCredentialStore(path string, passphrase []byte) CredentialStore {
+	return &fileCredentialStore{path: path, passphrase: passphrase, encrypted: true}
+}
+
+// Returned by an encrypted credential store if the file can't be decrypted with the passphrase.
+var ErrWrongPassphrase = errors.New("steam: wrong passphrase or damaged credential file")
+
+const (
+	credentialSaltSize   = 16
+	credentialIterations = 100000
+)
+
+type fileCredentialStore struct {
+	path       string
+	passphrase []byte
+	encrypted  bool
+
+	mutex sync.Mutex
+}
+
+func (s *fileCredentialStore) Load(account string) (*Credentials, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	accounts, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return accounts[account], nil
+}
+
+func (s *fileCredentialStore) Save(account string, credentials *Credentials) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	accounts, err := s.read()
+	if err != nil {
+		return err
+	}
+	accounts[account] = credentials
+	return s.write(accounts)
+}
+
+func (s *fileCredentialStore) read() (map[string]*Credentials, error) {
+	accounts := make(map[string]*Credentials)
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return accounts, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if s.encrypted {
+		if data, err = s.decrypt(data); err != nil {
+			return nil, err
+		}
+	}
+	if err = json.Unmarshal(data, &accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// Writes to a temporary file first, so that the old file is kept if writing fails.
+func (s *fileCredentialStore) write(accounts map[string]*Credentials) error {
+	data, err := json.MarshalIndent(accounts, "", "\t")
+	if err != nil {
+		return err
+	}
+	if s.encrypted {
+		if data, err = s.encrypt(data); err != nil {
+			return err
+		}
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// The file is the salt, followed by the nonce and the sealed data.
+func (s *fileCredentialStore) encrypt(data []byte) ([]byte, error) {
+	salt := make([]byte, credentialSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	out := append(salt, nonce...)
+	return gcm.Seal(out, nonce, data, nil), nil
+}
+
+func (s *fileCredentialStore) decrypt(data []byte) ([]byte, error) {
+	if len(data) < credentialSaltSize {
+		return nil, ErrWrongPassphrase
+	}
+	gcm, err := s.cipher(data[:credentialSaltSize])
+	if err != nil {
+		return nil, err
+	}
+	data = data[credentialSaltSize:]
+	if len(data) < gcm.NonceSize() {
+		return nil, ErrWrongPassphrase
+	}
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	return plain, nil
+}
+
+func (s *fileCredentialStore) cipher(salt []byte) (cipher.AEAD, error) {
+	key := cryptoutil.PBKDF2(s.passphrase, salt, credentialIterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Returns the stored credentials of the account, or nil if there is no store or it failed.
+func (c *Client) loadCredentials(account string) *Credentials {
+	if c.CredentialStore == nil || account == "" {
+		return nil
+	}
+	creds, err := c.CredentialStore.Load(account)
+	if err != nil {
+		c.Errorf("steam: error loading credentials of %v: %v", account, err)
+		return nil
+	}
+	return creds
+}
+
+// Changes the stored credentials of the account, if there is a store.
+func (c *Client) updateCredentials(account string, update func(creds *Credentials)) {
+	if c.CredentialStore == nil || account == "" {
+		return
+	}
+	creds, err := c.CredentialStore.Load(account)
+	if err != nil {
+		c.Errorf("steam: error loading credentials of %v: %v", account, err)
+		return
+	}
+	if creds == nil {
+		creds = new(Credentials)
+	}
+	update(creds)
+	if err = c.CredentialStore.Save(account, creds); err != nil {
+		c.Errorf("steam: error saving credentials of %v: %v", account, err)
+	}
+}
+
+// Returns a new machine ID: a binary KeyValues object with random hashes,
+// in the format of the Steam client.
+func generateMachineId() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte(0) // object
+	buf.WriteString("MessageObject\x00")
+	for _, key := range []string{"BB3", "FF2", "3B3"} {
+		random := make([]byte, 16)
+		rand.Read(random)
+		hash := sha1.Sum(random)
+		buf.WriteByte(1) // string
+		buf.WriteString(key + "\x00")
+		buf.WriteString(hex.EncodeToString(hash[:]) + "\x00")
+	}
+	buf.WriteByte(8) // end of object
+	buf.WriteByte(8) // end of document
+	return buf.Bytes()
+}
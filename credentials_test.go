@@ -0,0 +1,172 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFileCredentialStore(t *testing.T) {
+	dir := t.TempDir()
+	for _, store := range []CredentialStore{
+		NewMemoryCredentialStore(),
+		NewFileCredentialStore(filepath.Join(dir, "plain.json")),
+		NewEncryptedFileCredentialStore(filepath.Join(dir, "encrypted.bin"), []byte("hunter2")),
+	} {
+		if creds, err := store.Load("gopher"); creds != nil || err != nil {
+			t.Fatalf("Expected no credentials, got %v, %v", creds, err)
+		}
+		if err := store.Save("gopher", &Credentials{RefreshToken: "secret-token", MachineId: []byte{1, 2}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Save("gordon", &Credentials{LoginKey: "key"}); err != nil {
+			t.Fatal(err)
+		}
+		creds, err := store.Load("gopher")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if creds.RefreshToken != "secret-token" || !bytes.Equal(creds.MachineId, []byte{1, 2}) {
+			t.Fatalf("Unexpected credentials %+v", creds)
+		}
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "encrypted.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("secret-token")) {
+		t.Fatal("The encrypted file contains the token in plain text")
+	}
+	if info, err := os.Stat(filepath.Join(dir, "plain.json")); err != nil || info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected the file to be private, got %v", info.Mode())
+	}
+	wrong := NewEncryptedFileCredentialStore(filepath.Join(dir, "encrypted.bin"), []byte("hunter3"))
+	if _, err := wrong.Load("gopher"); err != ErrWrongPassphrase {
+		t.Fatalf("Expected ErrWrongPassphrase, got %v", err)
+	}
+}
+
+func TestLogOnWithStoredCredentials(t *testing.T) {
+	logons := make(chan *protobuf.CMsgClientLogon, 1)
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		packet, err := protocol.NewPacket(data)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		logon := new(protobuf.CMsgClientLogon)
+		packet.ReadProtoMsg(logon)
+		logons <- logon
+
+		// Steam updates the sentry after the logon
+		buf := new(bytes.Buffer)
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+			Eresult: proto.Int32(int32(steamlang.EResult_OK)),
+		}).Serialize(buf)
+		conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		buf.Reset()
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientUpdateMachineAuth, &protobuf.CMsgClientUpdateMachineAuth{
+			Filename: proto.String("ssfn"),
+		}).Serialize(buf)
+		conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+
+	store := NewMemoryCredentialStore()
+	store.Save("gopher", &Credentials{RefreshToken: "stored", SentryHash: []byte{1}})
+
+	client := NewClient()
+	client.CredentialStore = store
+	client.IgnoreEvents()
+	sub := client.Subscribe(&SubscribeOptions{Buffer: 10})
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect()
+
+	client.Auth.LogOn(&LogOnDetails{Username: "gopher"})
+	logon := <-logons
+	if logon.GetAccessToken() != "stored" || !bytes.Equal(logon.ShaSentryfile, []byte{1}) || logon.Password != nil {
+		t.Fatalf("Expected the stored credentials to be used, got %v", logon)
+	}
+	if !bytes.HasPrefix(logon.MachineId, []byte("\x00MessageObject\x00")) {
+		t.Fatalf("Expected a machine id, got %q", logon.MachineId)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for {
+		select {
+		case event := <-sub.Events():
+			if e, ok := event.(*MachineAuthUpdateEvent); ok {
+				creds, _ := store.Load("gopher")
+				if !bytes.Equal(creds.SentryHash, e.Hash) || !bytes.Equal(creds.MachineId, logon.MachineId) {
+					t.Fatalf("Expected the new sentry hash and the machine id to be stored, got %+v", creds)
+				}
+				return
+			}
+		case <-ctx.Done():
+			t.Fatal("Timed out waiting for the machine auth update")
+		}
+	}
+}
+
+func TestRejectedRefreshTokenIsForgotten(t *testing.T) {
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			t.Error(err)
+			return
+		}
+		buf := new(bytes.Buffer)
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+			Eresult: proto.Int32(int32(steamlang.EResult_AccessDenied)),
+		}).Serialize(buf)
+		conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		conn.ReadMessage()
+	})
+
+	store := NewMemoryCredentialStore()
+	store.Save("gopher", &Credentials{RefreshToken: "revoked", SentryHash: []byte{1}})
+
+	client := NewClient()
+	client.CredentialStore = store
+	client.IgnoreEvents()
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := client.Auth.LogOnContext(ctx, &LogOnDetails{Username: "gopher"})
+	if resultErr, ok := err.(*EResultError); !ok || resultErr.Result != steamlang.EResult_AccessDenied {
+		t.Fatalf("Expected EResult_AccessDenied, got %v", err)
+	}
+	creds, _ := store.Load("gopher")
+	if creds.RefreshToken != "" || !bytes.Equal(creds.SentryHash, []byte{1}) {
+		t.Fatalf("Expected only the refresh token to be removed, got %+v", creds)
+	}
+	if client.Auth.HasCredentials(&LogOnDetails{Username: "gopher"}) {
+		t.Fatal("Expected no credentials to be left")
+	}
+}
@@ -3,18 +3,42 @@ package cryptoutil
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha1"
+	"errors"
 )
 
 // Performs an encryption using AES/CBC/PKCS7
 // with a random IV prepended using AES/ECB/None.
 func SymmetricEncrypt(ciph cipher.Block, src []byte) []byte {
-	// get a random IV and ECB encrypt it
+	// get a random IV
 	iv := make([]byte, aes.BlockSize, aes.BlockSize)
 	_, err := rand.Read(iv)
 	if err != nil {
 		panic(err)
 	}
+	return symmetricEncryptWithIV(ciph, iv, src)
+}
+
+// Performs an encryption using AES/CBC/PKCS7 with an IV that is derived with HMAC-SHA1
+// from three random bytes and the plaintext, prepended using AES/ECB/None.
+// This is the mode that Steam uses for the channel encryption if the server sent a challenge.
+func SymmetricEncryptWithHMACIV(ciph cipher.Block, src, hmacSecret []byte) []byte {
+	random := make([]byte, 3)
+	_, err := rand.Read(random)
+	if err != nil {
+		panic(err)
+	}
+
+	iv := make([]byte, aes.BlockSize, aes.BlockSize)
+	copy(iv, hmacIV(hmacSecret, random, src))
+	copy(iv[aes.BlockSize-len(random):], random)
+	return symmetricEncryptWithIV(ciph, iv, src)
+}
+
+func symmetricEncryptWithIV(ciph cipher.Block, iv, src []byte) []byte {
+	// ECB encrypt the IV
 	encryptedIv := make([]byte, aes.BlockSize, aes.BlockSize)
 	newECBEncrypter(ciph).CryptBlocks(encryptedIv, iv)
 
@@ -27,6 +51,7 @@ func SymmetricEncrypt(ciph cipher.Block, src []byte) []byte {
 
 // Decrypts data from the reader using AES/CBC/PKCS7 with an IV
 // prepended using AES/ECB/None. The src slice may not be used anymore.
+// Returns nil if the padding is invalid.
 func SymmetricDecrypt(ciph cipher.Block, src []byte) []byte {
 	iv := src[:aes.BlockSize]
 	newECBDecrypter(ciph).CryptBlocks(iv, iv)
@@ -36,3 +61,39 @@ func SymmetricDecrypt(ciph cipher.Block, src []byte) []byte {
 
 	return unpadPKCS7(data)
 }
+
+// Returned by SymmetricDecryptWithHMACIV if the message was not encrypted with the same key and secret.
+var ErrInvalidHMAC = errors.New("cryptoutil: HMAC of the decrypted message does not match its IV")
+
+// Decrypts data encrypted with SymmetricEncryptWithHMACIV and verifies that the IV matches the
+// HMAC of the plaintext. The src slice may not be used anymore.
+func SymmetricDecryptWithHMACIV(ciph cipher.Block, src, hmacSecret []byte) ([]byte, error) {
+	if len(src) < 2*aes.BlockSize || len(src)%aes.BlockSize != 0 {
+		return nil, errors.New("cryptoutil: encrypted message has an invalid length")
+	}
+	iv := src[:aes.BlockSize]
+	newECBDecrypter(ciph).CryptBlocks(iv, iv)
+
+	data := src[aes.BlockSize:]
+	cipher.NewCBCDecrypter(ciph, iv).CryptBlocks(data, data)
+	padLen := int(data[len(data)-1])
+	if padLen == 0 || padLen > aes.BlockSize {
+		return nil, ErrInvalidHMAC
+	}
+	data = data[:len(data)-padLen]
+
+	random := iv[aes.BlockSize-3:]
+	expected := hmacIV(hmacSecret, random, data)
+	if !hmac.Equal(expected, iv[:aes.BlockSize-3]) {
+		return nil, ErrInvalidHMAC
+	}
+	return data, nil
+}
+
+// Returns the first 13 bytes of the HMAC-SHA1 of the random bytes followed by the plaintext.
+func hmacIV(hmacSecret, random, src []byte) []byte {
+	mac := hmac.New(sha1.New, hmacSecret)
+	mac.Write(random)
+	mac.Write(src)
+	return mac.Sum(nil)[:aes.BlockSize-len(random)]
+}
@@ -21,3 +21,24 @@ func TestCrypt(t *testing.T) {
 		t.Fatalf("src length (%v) does not match decrypted length (%v)!", len([]byte("Hello World!")), len(decrypted))
 	}
 }
+
+func TestCryptWithHMACIV(t *testing.T) {
+	src := []byte("Hello World!")
+	key := []byte("hunter2hunter2hunter2hunter2    ")
+	ciph, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := SymmetricEncryptWithHMACIV(ciph, src, key[:16])
+	decrypted, err := SymmetricDecryptWithHMACIV(ciph, append([]byte(nil), encrypted...), key[:16])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(decrypted) != string(src) {
+		t.Fatalf("Expected %q, got %q", src, decrypted)
+	}
+
+	if _, err = SymmetricDecryptWithHMACIV(ciph, encrypted, []byte("another secret  ")); err != ErrInvalidHMAC {
+		t.Fatalf("Expected ErrInvalidHMAC for the wrong secret, got %v", err)
+	}
+}
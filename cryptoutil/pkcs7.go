@@ -19,7 +19,14 @@ func padPKCS7WithIV(src []byte) []byte {
 	return dest
 }
 
+// Returns nil if the padding is invalid, for example because the data was decrypted with the wrong key.
 func unpadPKCS7(src []byte) []byte {
-	padLen := src[len(src)-1]
-	return src[:len(src)-int(padLen)]
+	if len(src) == 0 {
+		return nil
+	}
+	padLen := int(src[len(src)-1])
+	if padLen == 0 || padLen > len(src) {
+		return nil
+	}
+	return src[:len(src)-padLen]
 }
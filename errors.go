@@ -0,0 +1,28 @@
+package steam
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+)
+
+// Returned by the blocking API when the client is not connected or the
+// connection is lost before the operation completes.
+var ErrNotConnected = errors.New("steam: not connected")
+
+// Returned by the blocking API when Steam answers with a result other than EResult_OK.
+type EResultError struct {
+	// The operation that failed, for example "logon".
+	Op     string
+	Result steamlang.EResult
+	// An optional error message sent by Steam.
+	Message string
+}
+
+func (e *EResultError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("steam: %s failed: %v (%s)", e.Op, e.Result, e.Message)
+	}
+	return fmt.Sprintf("steam: %s failed: %v", e.Op, e.Result)
+}
@@ -44,6 +44,8 @@ func (g *GameCoordinator) HandlePacket(packet *protocol.Packet) {
 		return
 	}
 
+	g.client.log(LogGC).Debug("Received GC message", "appId", p.AppId, "msgType", p.MsgType, "proto", p.IsProto,
+		"size", len(p.Body), "targetJobId", p.TargetJobId)
 	for _, handler := range g.handlers {
 		handler.HandleGCPacket(p)
 	}
@@ -58,6 +60,8 @@ func (g *GameCoordinator) Write(msg gamecoordinator.IGCMsg) {
 		msgType = msgType | 0x80000000 // mask with protoMask
 	}
 
+	g.client.log(LogGC).Debug("Sending GC message", "appId", msg.GetAppId(), "msgType", msg.GetMsgType(), "proto", msg.IsProto(),
+		"size", buf.Len())
 	g.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientToGC, &protobuf.CMsgGCClient{
 		Msgtype: proto.Uint32(msgType),
 		Appid:   proto.Uint32(msg.GetAppId()),
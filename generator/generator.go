@@ -118,6 +118,7 @@ var clientProtoFiles = map[string]string{
 	"content_manifest.proto": "content_manifest.pb.go",
 
 	"steammessages_unified_base.steamclient.proto":      "unified/base.pb.go",
+	"steammessages_auth.steamclient.proto":              "unified/auth.pb.go",
 	"steammessages_cloud.steamclient.proto":             "unified/cloud.pb.go",
 	"steammessages_credentials.steamclient.proto":       "unified/credentials.pb.go",
 	"steammessages_deviceauth.steamclient.proto":        "unified/deviceauth.pb.go",
@@ -127,6 +128,7 @@ var clientProtoFiles = map[string]string{
 	"steammessages_partnerapps.steamclient.proto":       "unified/partnerapps.pb.go",
 	"steammessages_player.steamclient.proto":            "unified/player.pb.go",
 	"steammessages_publishedfile.steamclient.proto":     "unified/publishedfile.pb.go",
+	"steammessages_twofactor.steamclient.proto":         "unified/twofactor.pb.go",
 }
 
 var tf2ProtoFiles = map[string]string{
@@ -4,5 +4,6 @@ go 1.16
 
 require (
 	github.com/davecgh/go-spew v1.1.1
+	github.com/gorilla/websocket v1.5.0
 	google.golang.org/protobuf v1.27.1
 )
@@ -5,8 +5,8 @@
 // Every module is optional and requires an instance of the GsBot struct.
 // Should a module have a `HandlePacket` method, you must register it with the
 // steam.Client with `RegisterPacketHandler`. Any module with a `HandleEvent`
-// method must be integrated into your event loop and should be called for each
-// event you receive.
+// method must either be subscribed to the client's events with `GsBot.Subscribe`
+// or be integrated into your event loop and called for each event you receive.
 package gsbot
 
 import (
@@ -42,8 +42,20 @@ func Default() *GsBot {
 	}
 }
 
+// Implemented by modules that handle events, like Auth, ServerList and Debug.
+type EventHandler interface {
+	HandleEvent(event interface{})
+}
+
+// Subscribes the module to all events of the bot's client, so that you don't have to call
+// its HandleEvent method from your own event loop. Events are handled in a separate goroutine
+// for each module.
+func (bot *GsBot) Subscribe(module EventHandler) *steam.Subscription {
+	return bot.Client.On(module.HandleEvent, nil)
+}
+
 // This module handles authentication. It logs on automatically after a ConnectedEvent
-// and saves the sentry data to a file which is also used for logon if available.
+// and saves the sentry data to a file or a credential store which is also used for logon if available.
 // If you're logging on for the first time Steam may require an authcode. You can then
 // connect again with the new logon details.
 type Auth struct {
@@ -61,14 +73,32 @@ func NewAuth(bot *GsBot, details *steam.LogOnDetails, sentryPath string) *Auth {
 	}
 }
 
+// Creates an Auth module that keeps the sentry hash, login key and machine ID in the store
+// instead of a sentry file. The store is set as the CredentialStore of the bot's client, so
+// the details only need a password for the first logon. Without one, LogOn logs an error and disconnects.
+func NewAuthWithStore(bot *GsBot, details *steam.LogOnDetails, store steam.CredentialStore) *Auth {
+	bot.Client.CredentialStore = store
+	return &Auth{
+		bot:     bot,
+		details: details,
+	}
+}
+
 // This is called automatically after every ConnectedEvent, but must be called once again manually
 // with an authcode if Steam requires it when logging on for the first time.
 func (a *Auth) LogOn() {
-	sentry, err := ioutil.ReadFile(a.sentryPath)
-	if err != nil {
-		a.bot.Log.Printf("Error loading sentry file from path %v - This is normal if you're logging in for the first time.\n", a.sentryPath)
+	if a.sentryPath != "" {
+		sentry, err := ioutil.ReadFile(a.sentryPath)
+		if err != nil {
+			a.bot.Log.Printf("Error loading sentry file from path %v - This is normal if you're logging in for the first time.\n", a.sentryPath)
+		}
+		a.details.SentryFileHash = sentry
+	}
+	if !a.bot.Client.Auth.HasCredentials(a.details) {
+		a.bot.Log.Printf("No password, login key or refresh token for %v, connect again with a password.", a.details.Username)
+		a.bot.Client.Disconnect()
+		return
 	}
-	a.details.SentryFileHash = sentry
 	a.bot.Client.Auth.LogOn(a.details)
 }
 
@@ -80,9 +110,11 @@ func (a *Auth) HandleEvent(event interface{}) {
 		a.bot.Log.Printf("Logged on (%v) with SteamId %v and account flags %v", e.Result, e.ClientSteamId, e.AccountFlags)
 	case *steam.MachineAuthUpdateEvent:
 		a.machineAuthHash = e.Hash
-		err := ioutil.WriteFile(a.sentryPath, e.Hash, 0666)
-		if err != nil {
-			panic(err)
+		if a.sentryPath != "" {
+			err := ioutil.WriteFile(a.sentryPath, e.Hash, 0666)
+			if err != nil {
+				panic(err)
+			}
 		}
 	case *steam.LoginKeyEvent:
 		a.bot.Log.Printf("New LoginKey: %v\n", e.LoginKey)
@@ -10,6 +10,9 @@
 // Mobile code:  username + password + twofactorcode
 //               username + loginkey
 //
+// If Steam asks for a Steam Guard code that wasn't given, it is read from stdin.
+// The login key and sentry are kept in credentials.json, so afterwards the username is enough.
+//
 //     gsbot [username] [-p password] [-a authcode] [-t twofactorcode] [-l loginkey]
 
 package main
@@ -26,7 +29,7 @@ import (
 const usage string = "usage: gsbot [username] [-p password] [-a authcode] [-t twofactorcode] [-l loginkey]"
 
 func main() {
-	if len(os.Args) < 3 || len(os.Args)%2 != 0 {
+	if len(os.Args) < 2 || len(os.Args)%2 != 0 {
 		fmt.Println(usage)
 		return
 	}
@@ -34,6 +37,7 @@ func main() {
 	details := &steam.LogOnDetails{
 		Username:               os.Args[1],
 		ShouldRememberPassword: true,
+		GuardCodeProvider:      steam.StdinGuardCodeProvider(),
 	}
 
 	for i := 2; i < len(os.Args)-1; i += 2 {
@@ -54,25 +58,23 @@ func main() {
 
 	bot := gsbot.Default()
 	client := bot.Client
-	auth := gsbot.NewAuth(bot, details, "sentry.bin")
+	auth := gsbot.NewAuthWithStore(bot, details, steam.NewFileCredentialStore("credentials.json"))
 	debug, err := gsbot.NewDebug(bot, "debug")
 	if err != nil {
 		panic(err)
 	}
 	client.RegisterPacketHandler(debug)
 	serverList := gsbot.NewServerList(bot, "serverlist.json")
-	serverList.Connect()
 
-	for event := range client.Events() {
-		auth.HandleEvent(event)
-		debug.HandleEvent(event)
-		serverList.HandleEvent(event)
+	bot.Subscribe(auth)
+	bot.Subscribe(debug)
+	bot.Subscribe(serverList)
+	client.SetLogger(steam.NewStdLogger(bot.Log, "info"))
+	client.On(func(e *steam.LoggedOnEvent) {
+		client.Social.SetPersonaState(steamlang.EPersonaState_Online)
+	}, nil)
+	client.IgnoreEvents()
 
-		switch e := event.(type) {
-		case error:
-			fmt.Printf("Error: %v", e)
-		case *steam.LoggedOnEvent:
-			client.Social.SetPersonaState(steamlang.EPersonaState_Online)
-		}
-	}
+	serverList.Connect()
+	select {}
 }
@@ -0,0 +1,147 @@
+package steam
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/totp"
+)
+
+// Returns the Steam Guard code asked for by the event, see LogOnDetails.GuardCodeProvider.
+// It is called in its own goroutine and may block, for example while waiting for user input.
+// If it returns an error, the logon fails with a LogOnFailedEvent.
+type GuardCodeProvider func(e *SteamGuardRequiredEvent) (string, error)
+
+// The number of times a logon is retried with a code from the GuardCodeProvider before giving up.
+const maxGuardCodeAttempts = 3
+
+// The time the reconnect for a retry with a Steam Guard code may take.
+const guardRetryTimeout = 30 * time.Second
+
+// The time a generated Steam Guard code must stay valid for the logon to reach Steam in time.
+const guardCodeMinValid = 5 * time.Second
+
+// Returns a provider that generates the code of the mobile authenticator with the given shared secret.
+// It fails if Steam asks for an email code.
+func TotpGuardCodeProvider(sharedSecret string) GuardCodeProvider {
+	return func(e *SteamGuardRequiredEvent) (string, error) {
+		if e.Email {
+			return "", errors.New("steam: Steam Guard sent an email code, but only a mobile authenticator secret is known")
+		}
+		return totp.GenerateTotpCode(sharedSecret, time.Now())
+	}
+}
+
+// Like TotpGuardCodeProvider, but generates the code for Steam's time. The TimeSync is synced on
+// first use; if that fails, the local clock is used. If the code would expire within a few seconds,
+// the provider waits for the next one.
+func SyncedTotpGuardCodeProvider(sharedSecret string, sync *totp.TimeSync) GuardCodeProvider {
+	return func(e *SteamGuardRequiredEvent) (string, error) {
+		if e.Email {
+			return "", errors.New("steam: Steam Guard sent an email code, but only a mobile authenticator secret is known")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), guardRetryTimeout)
+		defer cancel()
+		if !sync.Synced() {
+			sync.Sync(ctx)
+		}
+		if err := sync.WaitForFreshCode(ctx, guardCodeMinValid); err != nil {
+			return "", err
+		}
+		return sync.GenerateCode(sharedSecret)
+	}
+}
+
+// Returns a provider that asks for the code on stdout and reads it from stdin.
+func StdinGuardCodeProvider() GuardCodeProvider {
+	return ReaderGuardCodeProvider(os.Stdin, os.Stdout)
+}
+
+// Returns a provider that writes a prompt to w and reads the code from the next line of r.
+func ReaderGuardCodeProvider(r io.Reader, w io.Writer) GuardCodeProvider {
+	reader := bufio.NewReader(r)
+	return func(e *SteamGuardRequiredEvent) (string, error) {
+		if e.LastCodeWrong {
+			fmt.Fprint(w, "The Steam Guard code was wrong. ")
+		}
+		if e.Email {
+			fmt.Fprintf(w, "Enter the Steam Guard code sent to your email at %v: ", e.EmailDomain)
+		} else {
+			fmt.Fprint(w, "Enter the code of your Steam Guard mobile authenticator: ")
+		}
+		line, err := reader.ReadString('\n')
+		code := strings.TrimSpace(line)
+		if code == "" {
+			if err == nil {
+				err = errors.New("steam: no Steam Guard code entered")
+			}
+			return "", err
+		}
+		return code, nil
+	}
+}
+
+// Returns the event for a logon result that asks for a Steam Guard code, or nil for other results.
+func newSteamGuardRequiredEvent(result steamlang.EResult, emailDomain string) *SteamGuardRequiredEvent {
+	switch result {
+	case steamlang.EResult_AccountLogonDenied, steamlang.EResult_InvalidLoginAuthCode:
+		return &SteamGuardRequiredEvent{
+			Result:        result,
+			Email:         true,
+			EmailDomain:   emailDomain,
+			LastCodeWrong: result == steamlang.EResult_InvalidLoginAuthCode,
+		}
+	case steamlang.EResult_AccountLoginDeniedNeedTwoFactor, steamlang.EResult_TwoFactorCodeMismatch:
+		return &SteamGuardRequiredEvent{
+			Result:        result,
+			LastCodeWrong: result == steamlang.EResult_TwoFactorCodeMismatch,
+		}
+	}
+	return nil
+}
+
+// Returns the details to retry the logon with if they have a GuardCodeProvider and there are
+// attempts left, or nil if the logon fails instead.
+func (a *Auth) guardRetryDetails() *LogOnDetails {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.details == nil || a.details.GuardCodeProvider == nil || a.guardAttempts >= maxGuardCodeAttempts {
+		return nil
+	}
+	a.guardAttempts++
+	a.guardRetry = true
+	return a.details
+}
+
+// Asks the provider for a code, reconnects to the same server and logs on again with it.
+func (a *Auth) retryWithGuardCode(details *LogOnDetails, event *SteamGuardRequiredEvent) {
+	code, err := details.GuardCodeProvider(event)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), guardRetryTimeout)
+		err = a.client.reconnectToLastServer(ctx)
+		cancel()
+	}
+	if err != nil {
+		a.mutex.Lock()
+		a.guardRetry = false
+		a.mutex.Unlock()
+		a.client.Errorf("steam: error retrying logon with a Steam Guard code: %v", err)
+		a.client.Emit(&LogOnFailedEvent{Result: event.Result})
+		return
+	}
+
+	retry := *details
+	if event.Email {
+		retry.AuthCode = code
+	} else {
+		retry.TwoFactorCode = code
+	}
+	a.logOn(&retry)
+}
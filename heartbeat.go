@@ -0,0 +1,157 @@
+package steam
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"google.golang.org/protobuf/proto"
+)
+
+// The number of heartbeat intervals without any packet from the server after which
+// the connection is considered dead if Client.ConnectionTimeout is not set.
+const defaultConnectionTimeoutIntervals = 3
+
+// Sends heartbeats on one connection and closes it if the server stops sending anything.
+// Along with every heartbeat, the server time is requested to measure the round-trip time.
+type heartbeat struct {
+	client   *Client
+	conn     connection
+	interval time.Duration
+	timeout  time.Duration
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	mutex     sync.Mutex // guarding probeId and probeSent
+	probeId   uint64
+	probeSent time.Time
+}
+
+// Starts sending heartbeats on the current connection at the given interval,
+// replacing the heartbeats of a previous logon.
+func (c *Client) startHeartbeat(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	timeout := c.ConnectionTimeout
+	if timeout <= 0 {
+		timeout = defaultConnectionTimeoutIntervals * interval
+	}
+
+	c.mutex.Lock()
+	if c.conn == nil {
+		c.mutex.Unlock()
+		return
+	}
+	if c.heartbeat != nil {
+		c.heartbeat.Stop()
+	}
+	h := &heartbeat{
+		client:   c,
+		conn:     c.conn,
+		interval: interval,
+		timeout:  timeout,
+		stop:     make(chan struct{}),
+	}
+	c.heartbeat = h
+	c.mutex.Unlock()
+
+	c.log(LogClient).Debug("Starting heartbeats", "interval", interval, "timeout", timeout)
+	go h.run()
+}
+
+func (h *heartbeat) Stop() {
+	h.stopOnce.Do(func() {
+		close(h.stop)
+	})
+}
+
+func (h *heartbeat) run() {
+	heartbeats := time.NewTicker(h.interval)
+	defer heartbeats.Stop()
+	checks := time.NewTicker(h.timeout / 3)
+	defer checks.Stop()
+
+	h.probe()
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-heartbeats.C:
+			h.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientHeartBeat, new(protobuf.CMsgClientHeartBeat)))
+			h.probe()
+		case <-checks.C:
+			if idle := time.Since(h.client.lastReceived()); idle > h.timeout {
+				h.client.connectionTimedOut(h.conn, idle)
+				return
+			}
+		}
+	}
+}
+
+// Requests the server time. The server echoes our timestamp, which is used to match the response.
+func (h *heartbeat) probe() {
+	now := time.Now()
+	id := uint64(now.UnixNano() / int64(time.Millisecond))
+	h.mutex.Lock()
+	h.probeId = id
+	h.probeSent = now
+	h.mutex.Unlock()
+	h.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientServerTimestampRequest, &protobuf.CMsgClientServerTimestampRequest{
+		ClientRequestTimestamp: proto.Uint64(id),
+	}))
+}
+
+// Returns the round-trip time if the response belongs to the last probe.
+func (h *heartbeat) handleResponse(body *protobuf.CMsgClientServerTimestampResponse) (time.Duration, bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.probeSent.IsZero() || body.GetClientRequestTimestamp() != h.probeId {
+		return 0, false
+	}
+	rtt := time.Since(h.probeSent)
+	h.probeSent = time.Time{}
+	return rtt, true
+}
+
+// Returns the round-trip time to the server, measured with the last heartbeat.
+// Returns zero if it hasn't been measured on the current connection yet.
+func (c *Client) Latency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&c.latency))
+}
+
+func (c *Client) lastReceived() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&c.lastReceivedAt))
+}
+
+func (c *Client) handleServerTimestampResponse(packet *protocol.Packet) {
+	body := new(protobuf.CMsgClientServerTimestampResponse)
+	packet.ReadProtoMsg(body)
+
+	c.mutex.RLock()
+	h := c.heartbeat
+	c.mutex.RUnlock()
+	if h == nil {
+		return
+	}
+	if rtt, ok := h.handleResponse(body); ok {
+		atomic.StoreInt64(&c.latency, int64(rtt))
+		c.log(LogClient).Debug("Measured latency", "rtt", rtt)
+	}
+}
+
+// Closes conn after the server hasn't sent anything for too long, unless it has already been replaced.
+func (c *Client) connectionTimedOut(conn connection, idle time.Duration) {
+	c.mutex.RLock()
+	current := c.conn == conn
+	c.mutex.RUnlock()
+	if !current {
+		return
+	}
+	c.log(LogClient).Warn("Connection timed out", "idle", idle)
+	c.Emit(&ConnectionTimeoutEvent{Idle: idle})
+	c.disconnect(false)
+}
@@ -0,0 +1,153 @@
+package steam
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+)
+
+// The time to wait for the response to a job if Client.JobTimeout is not set.
+const DefaultJobTimeout = 30 * time.Second
+
+// Returned by a Job when no response arrived in time.
+var ErrJobTimeout = errors.New("steam: job timed out")
+
+// Returned by a Job that was cancelled before a response arrived.
+var ErrJobCancelled = errors.New("steam: job cancelled")
+
+// A request sent with a source job id. It completes when Steam answers with a packet
+// whose target job id matches, when it times out or when the client disconnects.
+type Job struct {
+	Id protocol.JobId
+
+	jobs   *jobManager
+	timer  *time.Timer
+	done   chan struct{}
+	packet *protocol.Packet
+	err    error
+}
+
+// Returns a channel that is closed when the job has completed.
+func (j *Job) Done() <-chan struct{} {
+	return j.done
+}
+
+// Returns the response packet or the error the job failed with.
+// This may only be called after Done() has been closed.
+func (j *Job) Result() (*protocol.Packet, error) {
+	return j.packet, j.err
+}
+
+// Waits for the job to complete and returns its result.
+// If the context is done first, the job is cancelled and the context's error is returned.
+func (j *Job) Wait(ctx context.Context) (*protocol.Packet, error) {
+	select {
+	case <-j.done:
+		return j.packet, j.err
+	case <-ctx.Done():
+		j.Cancel()
+		return nil, ctx.Err()
+	}
+}
+
+// Stops waiting for the response. Does nothing if the job has already completed.
+func (j *Job) Cancel() {
+	j.jobs.finish(j.Id, nil, ErrJobCancelled)
+}
+
+// Keeps track of outstanding jobs by their source job id.
+type jobManager struct {
+	mutex sync.Mutex
+	jobs  map[protocol.JobId]*Job
+}
+
+func newJobManager() *jobManager {
+	return &jobManager{
+		jobs: make(map[protocol.JobId]*Job),
+	}
+}
+
+func (m *jobManager) add(id protocol.JobId, timeout time.Duration) *Job {
+	job := &Job{
+		Id:   id,
+		jobs: m,
+		done: make(chan struct{}),
+	}
+	m.mutex.Lock()
+	m.jobs[id] = job
+	job.timer = time.AfterFunc(timeout, func() {
+		m.finish(id, nil, ErrJobTimeout)
+	})
+	m.mutex.Unlock()
+	return job
+}
+
+// Completes the job with the given id. Returns false if there is no such job.
+func (m *jobManager) finish(id protocol.JobId, packet *protocol.Packet, err error) bool {
+	m.mutex.Lock()
+	job, ok := m.jobs[id]
+	delete(m.jobs, id)
+	m.mutex.Unlock()
+	if !ok {
+		return false
+	}
+
+	job.timer.Stop()
+	job.packet = packet
+	job.err = err
+	close(job.done)
+	return true
+}
+
+// Completes the job that the packet is a response to, if there is one.
+func (m *jobManager) handlePacket(packet *protocol.Packet) bool {
+	if packet.TargetJobId == 0 || packet.TargetJobId == math.MaxUint64 {
+		return false
+	}
+	return m.finish(packet.TargetJobId, packet, nil)
+}
+
+// Fails all outstanding jobs with the given error.
+func (m *jobManager) failAll(err error) {
+	m.mutex.Lock()
+	ids := make([]protocol.JobId, 0, len(m.jobs))
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	m.mutex.Unlock()
+
+	for _, id := range ids {
+		m.finish(id, nil, err)
+	}
+}
+
+// Assigns a new source job id to the message, sends it and returns a Job that completes
+// with Steam's response. The job fails with ErrJobTimeout after JobTimeout and with
+// ErrNotConnected if the client is not connected or disconnects before a response arrives.
+//
+// The response is passed to the registered PacketHandlers as usual.
+func (c *Client) WriteJob(msg protocol.IMsg) *Job {
+	timeout := c.JobTimeout
+	if timeout <= 0 {
+		timeout = DefaultJobTimeout
+	}
+
+	id := c.GetNextJobId()
+	msg.SetSourceJobId(id)
+	job := c.jobs.add(id, timeout)
+	if !c.Connected() {
+		c.jobs.finish(id, nil, ErrNotConnected)
+		return job
+	}
+	c.Write(msg)
+	return job
+}
+
+// Sends the message as a job and waits for the response.
+func (c *Client) Call(ctx context.Context, msg protocol.IMsg) (*protocol.Packet, error) {
+	return c.WriteJob(msg).Wait(ctx)
+}
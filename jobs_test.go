@@ -0,0 +1,74 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+)
+
+func newResponsePacket(t *testing.T, target protocol.JobId) *protocol.Packet {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientFriendProfileInfoResponse, new(protobuf.CMsgClientFriendProfileInfoResponse))
+	msg.SetTargetJobId(target)
+	buf := new(bytes.Buffer)
+	if err := msg.Serialize(buf); err != nil {
+		t.Fatal(err)
+	}
+	packet, err := protocol.NewPacket(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return packet
+}
+
+func TestJobResponse(t *testing.T) {
+	jobs := newJobManager()
+	job := jobs.add(7, time.Minute)
+
+	if jobs.handlePacket(newResponsePacket(t, 8)) {
+		t.Fatal("Packet for another job must not complete the job")
+	}
+	if !jobs.handlePacket(newResponsePacket(t, 7)) {
+		t.Fatal("Expected the packet to complete the job")
+	}
+
+	packet, err := job.Wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if packet.TargetJobId != 7 {
+		t.Fatalf("Expected target job id 7, got %v", packet.TargetJobId)
+	}
+	if jobs.handlePacket(newResponsePacket(t, 7)) {
+		t.Fatal("A job must only complete once")
+	}
+}
+
+func TestJobTimeoutAndDisconnect(t *testing.T) {
+	jobs := newJobManager()
+	timedOut := jobs.add(1, time.Millisecond)
+	if _, err := timedOut.Wait(context.Background()); err != ErrJobTimeout {
+		t.Fatalf("Expected ErrJobTimeout, got %v", err)
+	}
+
+	pending := jobs.add(2, time.Minute)
+	jobs.failAll(ErrNotConnected)
+	if _, err := pending.Wait(context.Background()); err != ErrNotConnected {
+		t.Fatalf("Expected ErrNotConnected, got %v", err)
+	}
+	if len(jobs.jobs) != 0 {
+		t.Fatalf("Expected all jobs to be cleaned up, %v left", len(jobs.jobs))
+	}
+}
+
+func TestWriteJobNotConnected(t *testing.T) {
+	client := NewClient()
+	job := client.WriteJob(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientFriendProfileInfo, new(protobuf.CMsgClientFriendProfileInfo)))
+	if _, err := job.Wait(context.Background()); err != ErrNotConnected {
+		t.Fatalf("Expected ErrNotConnected, got %v", err)
+	}
+}
@@ -2,11 +2,15 @@ package steam
 
 import (
 	"crypto/rsa"
+	"crypto/x509"
+	"sync"
 
 	"github.com/Philipp15b/go-steam/v3/cryptoutil"
 	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
 )
 
+// The DER-encoded public keys of the universes, as in SteamKit. The key of the dev universe
+// isn't built in and has to be registered with SetPublicKey.
 var publicKeys = map[steamlang.EUniverse][]byte{
 	steamlang.EUniverse_Public: {
 		0x30, 0x81, 0x9D, 0x30, 0x0D, 0x06, 0x09, 0x2A, 0x86, 0x48, 0x86, 0xF7, 0x0D, 0x01, 0x01, 0x01,
@@ -31,6 +35,7 @@ var publicKeys = map[steamlang.EUniverse][]byte{
 		0x18, 0xCB, 0x6D, 0x52, 0x44, 0x4E, 0x05, 0x92, 0x89, 0x6A, 0xA8, 0x99, 0xED, 0x44, 0xAE, 0xE2,
 		0x66, 0x46, 0x42, 0x0C, 0xFB, 0x6E, 0x4C, 0x30, 0xC6, 0x6C, 0x5C, 0x16, 0xFF, 0xBA, 0x9C, 0xB9,
 		0x78, 0x3F, 0x17, 0x4B, 0xCB, 0xC9, 0x01, 0x5D, 0x3E, 0x37, 0x70, 0xEC, 0x67, 0x5A, 0x33, 0x48,
+		0xF7, 0x46, 0xCE, 0x58, 0xAA, 0xEC, 0xD9, 0xFF, 0x4A, 0x78, 0x6C, 0x83, 0x4B, 0x02, 0x01, 0x11,
 	},
 
 	steamlang.EUniverse_Internal: {
@@ -43,11 +48,17 @@ var publicKeys = map[steamlang.EUniverse][]byte{
 		0x7B, 0x51, 0xD6, 0x22, 0x62, 0x5C, 0x1E, 0x58, 0xD7, 0x46, 0x1E, 0x09, 0xAE, 0x43, 0xA7, 0xC4,
 		0x34, 0x69, 0xA2, 0xA5, 0xE8, 0x44, 0x76, 0x18, 0xE2, 0x3D, 0xB7, 0xC5, 0xA8, 0x96, 0xFD, 0xE5,
 		0xB4, 0x4B, 0xF8, 0x40, 0x12, 0xA6, 0x17, 0x4E, 0xC4, 0xC1, 0x60, 0x0E, 0xB0, 0xC2, 0xB8, 0x40,
+		0x4D, 0x9E, 0x76, 0x4C, 0x44, 0xF4, 0xFC, 0x6F, 0x14, 0x89, 0x73, 0xB4, 0x13, 0x02, 0x01, 0x11,
 	},
 }
 
+var publicKeysMutex sync.RWMutex
+
+// Returns the public key of the given universe, or nil if none is known.
 func GetPublicKey(universe steamlang.EUniverse) *rsa.PublicKey {
+	publicKeysMutex.RLock()
 	bytes, ok := publicKeys[universe]
+	publicKeysMutex.RUnlock()
 	if !ok {
 		return nil
 	}
@@ -57,3 +68,17 @@ func GetPublicKey(universe steamlang.EUniverse) *rsa.PublicKey {
 	}
 	return key
 }
+
+// Registers the public key that is used to encrypt the session key when connecting to a server of the given universe,
+// replacing any existing one. Use this to connect to the dev universe or to a server with its own key. It applies to all clients
+// of the process; set Client.PublicKey to use another key for a single client.
+func SetPublicKey(universe steamlang.EUniverse, key *rsa.PublicKey) error {
+	bytes, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return err
+	}
+	publicKeysMutex.Lock()
+	publicKeys[universe] = bytes
+	publicKeysMutex.Unlock()
+	return nil
+}
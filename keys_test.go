@@ -0,0 +1,23 @@
+package steam
+
+import (
+	"testing"
+
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+)
+
+func TestBuiltInPublicKeys(t *testing.T) {
+	for _, universe := range []steamlang.EUniverse{
+		steamlang.EUniverse_Public,
+		steamlang.EUniverse_Beta,
+		steamlang.EUniverse_Internal,
+	} {
+		key := GetPublicKey(universe)
+		if key == nil {
+			t.Fatalf("Expected a key for universe %v", universe)
+		}
+		if key.N.BitLen() != 1024 || key.E != 17 {
+			t.Fatalf("Unexpected key for universe %v: %v bits, exponent %v", universe, key.N.BitLen(), key.E)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package steam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+)
+
+// Receives the log messages of a Client. Its method set matches *slog.Logger, so a slog logger
+// can be passed directly. The args are alternating keys and values.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// A set of subsystems whose messages are logged. See Client.SetLogSubsystems.
+type LogSubsystem uint32
+
+const (
+	// Connecting, the channel encryption handshake, heartbeats and errors of the client itself.
+	LogClient LogSubsystem = 1 << iota
+	// A trace of every packet that is sent or received, with its EMsg, size and job ids.
+	LogPackets
+	// Logging on and off, login keys and machine auth.
+	LogAuth
+	// Logging on to the Web API.
+	LogWeb
+	// Messages to and from game coordinators.
+	LogGC
+
+	// Everything except the packet trace. This is what is logged by default.
+	LogDefault = LogClient | LogAuth | LogWeb | LogGC
+	// Everything.
+	LogAll = LogDefault | LogPackets
+)
+
+// Sets the logger that receives the messages of this client. Pass nil to turn logging off, which is the default.
+// Errors are still emitted as events in any case.
+func (c *Client) SetLogger(logger Logger) {
+	c.logger.Store(loggerHolder{logger})
+}
+
+// Sets the subsystems whose messages are logged. Defaults to LogDefault.
+// This can be changed at any time, for example to trace the packets while debugging a problem.
+func (c *Client) SetLogSubsystems(subsystems LogSubsystem) {
+	atomic.StoreUint32(&c.logSubsystems, uint32(subsystems))
+}
+
+// Returns the logger for messages of the given subsystem, which discards them if the subsystem is turned off.
+func (c *Client) log(subsystem LogSubsystem) Logger {
+	if LogSubsystem(atomic.LoadUint32(&c.logSubsystems))&subsystem == 0 {
+		return nopLogger{}
+	}
+	holder, _ := c.logger.Load().(loggerHolder)
+	if holder.Logger == nil {
+		return nopLogger{}
+	}
+	return holder.Logger
+}
+
+// Wraps the logger so that atomic.Value always stores the same type.
+type loggerHolder struct {
+	Logger
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, args ...interface{}) {}
+func (nopLogger) Info(msg string, args ...interface{})  {}
+func (nopLogger) Warn(msg string, args ...interface{})  {}
+func (nopLogger) Error(msg string, args ...interface{}) {}
+
+// Returns a Logger that writes to a standard library logger, one line per message:
+//
+//	INFO Connected server=162.254.197.42:27017
+//
+// Messages below minLevel ("debug", "info", "warn" or "error") are dropped.
+func NewStdLogger(logger *log.Logger, minLevel string) Logger {
+	level := 0
+	for i, name := range stdLogLevels {
+		if strings.EqualFold(name, minLevel) {
+			level = i
+		}
+	}
+	return &stdLogger{logger, level}
+}
+
+var stdLogLevels = []string{"DEBUG", "INFO", "WARN", "ERROR"}
+
+type stdLogger struct {
+	logger   *log.Logger
+	minLevel int
+}
+
+func (l *stdLogger) Debug(msg string, args ...interface{}) { l.print(0, msg, args) }
+func (l *stdLogger) Info(msg string, args ...interface{})  { l.print(1, msg, args) }
+func (l *stdLogger) Warn(msg string, args ...interface{})  { l.print(2, msg, args) }
+func (l *stdLogger) Error(msg string, args ...interface{}) { l.print(3, msg, args) }
+
+func (l *stdLogger) print(level int, msg string, args []interface{}) {
+	if level < l.minLevel {
+		return
+	}
+	var b strings.Builder
+	b.WriteString(stdLogLevels[level])
+	b.WriteByte(' ')
+	b.WriteString(msg)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 < len(args) {
+			fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+		} else {
+			fmt.Fprintf(&b, " %v", args[i])
+		}
+	}
+	l.logger.Print(b.String())
+}
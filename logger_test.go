@@ -0,0 +1,68 @@
+package steam
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sync"
+	"testing"
+)
+
+type recordingLogger struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+func (l *recordingLogger) record(level, msg string, args []interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.messages = append(l.messages, fmt.Sprint(level, " ", msg, args))
+}
+
+func (l *recordingLogger) Debug(msg string, args ...interface{}) { l.record("DEBUG", msg, args) }
+func (l *recordingLogger) Info(msg string, args ...interface{})  { l.record("INFO", msg, args) }
+func (l *recordingLogger) Warn(msg string, args ...interface{})  { l.record("WARN", msg, args) }
+func (l *recordingLogger) Error(msg string, args ...interface{}) { l.record("ERROR", msg, args) }
+
+func (l *recordingLogger) Messages() []string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return append([]string(nil), l.messages...)
+}
+
+func TestLogSubsystems(t *testing.T) {
+	client := NewClient()
+	client.Errorf("not logged")
+
+	logger := new(recordingLogger)
+	client.SetLogger(logger)
+	client.Errorf("logged %d", 1)
+	client.SetLogSubsystems(LogPackets)
+	client.Errorf("not logged")
+	client.SetLogSubsystems(LogAll)
+	client.log(LogPackets).Debug("Received packet", "emsg", 1)
+
+	messages := logger.Messages()
+	expected := []string{"ERROR logged 1[]", "DEBUG Received packet[emsg 1]"}
+	if len(messages) != len(expected) {
+		t.Fatalf("Expected %q, got %q", expected, messages)
+	}
+	for i := range expected {
+		if messages[i] != expected[i] {
+			t.Fatalf("Expected %q, got %q", expected, messages)
+		}
+	}
+}
+
+func TestStdLogger(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := NewStdLogger(log.New(buf, "", 0), "info")
+	logger.Debug("dropped")
+	logger.Info("Connected", "server", "127.0.0.1:27017", "hmac", true)
+	logger.Error("odd", "key")
+
+	expected := "INFO Connected server=127.0.0.1:27017 hmac=true\nERROR odd key\n"
+	if buf.String() != expected {
+		t.Fatalf("Expected %q, got %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,317 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+)
+
+// The state of an account.
+type State int
+
+const (
+	// The client is connecting for the first time.
+	Connecting State = iota
+	// The client is connected and waiting for its turn to log on, or for the result.
+	LoggingOn
+	// The client is logged on.
+	Online
+	// The connection was lost and the reconnect supervisor is trying to restore it.
+	Reconnecting
+	// Steam rejected the logon or the reconnect supervisor gave up. See Status.LastError.
+	Failed
+	// The client was disconnected on purpose.
+	Stopped
+)
+
+func (s State) String() string {
+	switch s {
+	case Connecting:
+		return "Connecting"
+	case LoggingOn:
+		return "LoggingOn"
+	case Online:
+		return "Online"
+	case Reconnecting:
+		return "Reconnecting"
+	case Failed:
+		return "Failed"
+	case Stopped:
+		return "Stopped"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// The health of an account.
+type Status struct {
+	Account string
+	State   State
+	// The time the account entered the state.
+	Since time.Time
+	// The Steam ID of the last logon.
+	SteamId steamid.SteamId
+	// The round-trip time to the server, see steam.Client.Latency.
+	Latency time.Duration
+	// The number of reconnect attempts so far.
+	Reconnects int
+	// The last error the client reported, or nil after a successful logon.
+	LastError error
+}
+
+type account struct {
+	manager *Manager
+	name    string
+	client  *steam.Client
+	sub     *steam.Subscription
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mutex       sync.Mutex // guarding details and the status fields
+	details     steam.LogOnDetails
+	state       State
+	since       time.Time
+	steamId     steamid.SteamId
+	reconnects  int
+	lastError   error
+	logOnFailed bool
+	guardRetry  bool
+}
+
+func newAccount(m *Manager, a *Account, client *steam.Client) *account {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &account{
+		manager: m,
+		name:    a.Details.Username,
+		client:  client,
+		sub:     client.Subscribe(&steam.SubscribeOptions{Buffer: 64}),
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		details: *a.Details,
+		state:   Connecting,
+		since:   time.Now(),
+	}
+}
+
+func (a *account) start() {
+	go a.eventLoop()
+	go a.connect()
+}
+
+// Logs off and waits until all events have been forwarded.
+func (a *account) stop() {
+	a.mutex.Lock()
+	a.cancel()
+	a.mutex.Unlock()
+	a.client.DisableAutoReconnect()
+	a.client.Auth.LogOff()
+	a.client.Disconnect()
+	a.sub.Unsubscribe()
+	<-a.done
+	a.setState(Stopped)
+}
+
+func (a *account) status() Status {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return Status{
+		Account:    a.name,
+		State:      a.state,
+		Since:      a.since,
+		SteamId:    a.steamId,
+		Latency:    a.client.Latency(),
+		Reconnects: a.reconnects,
+		LastError:  a.lastError,
+	}
+}
+
+func (a *account) setState(state State) {
+	a.mutex.Lock()
+	a.setStateLocked(state)
+	a.mutex.Unlock()
+}
+
+func (a *account) setStateLocked(state State) {
+	if a.state != state {
+		a.state = state
+		a.since = time.Now()
+	}
+}
+
+// Connects for the first time, retrying with a backoff. Afterwards, the reconnect supervisor takes over.
+func (a *account) connect() {
+	m := a.manager
+	backoff := time.Second
+	for {
+		ctx, cancel := context.WithTimeout(a.ctx, m.opts.ConnectTimeout)
+		var err error
+		if server := m.randomServer(); server != nil {
+			err = a.client.ConnectToContext(ctx, server)
+		} else {
+			_, err = a.client.ConnectContext(ctx)
+		}
+		cancel()
+		if err == nil {
+			break
+		}
+		if a.ctx.Err() != nil {
+			return
+		}
+		a.mutex.Lock()
+		a.lastError = err
+		a.mutex.Unlock()
+
+		select {
+		case <-time.After(backoff):
+		case <-a.ctx.Done():
+			return
+		}
+		if backoff *= 2; backoff > 2*time.Minute {
+			backoff = 2 * time.Minute
+		}
+	}
+
+	opts := m.opts.Reconnect
+	// the list of the manager changes as clients receive new ones
+	opts.ServerList = m.serverList
+	opts.SkipLogOn = true
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.ctx.Err() != nil {
+		// stopped while connecting
+		a.client.Disconnect()
+		return
+	}
+	a.client.EnableAutoReconnect(&opts)
+}
+
+// Waits for a free logon slot and logs on with the account's details.
+func (a *account) logOn() {
+	if err := a.manager.limiter.wait(a.ctx); err != nil {
+		return
+	}
+	if !a.client.Connected() {
+		return
+	}
+
+	a.mutex.Lock()
+	if a.guardRetry || a.state != LoggingOn {
+		// Auth has logged on with a Steam Guard code while we were waiting
+		a.mutex.Unlock()
+		return
+	}
+	details := a.details
+	// Steam Guard codes can only be used once
+	a.details.AuthCode = ""
+	a.details.TwoFactorCode = ""
+	a.mutex.Unlock()
+
+	if details.LoginKey != "" || details.RefreshToken != "" {
+		details.Password = ""
+	}
+	if !a.client.Auth.HasCredentials(&details) {
+		// the stored refresh token was rejected and there is no password to fall back to
+		a.mutex.Lock()
+		a.lastError = ErrNoCredentials
+		a.logOnFailed = true
+		a.mutex.Unlock()
+		a.client.Disconnect()
+		return
+	}
+	a.client.Auth.LogOn(&details)
+}
+
+func (a *account) eventLoop() {
+	defer close(a.done)
+	for event := range a.sub.Events() {
+		a.handleEvent(event)
+		a.manager.emit(a.ctx, a.name, event)
+	}
+}
+
+func (a *account) handleEvent(event interface{}) {
+	switch e := event.(type) {
+	case *steam.ConnectedEvent:
+		a.mutex.Lock()
+		a.setStateLocked(LoggingOn)
+		retrying := a.guardRetry
+		a.mutex.Unlock()
+		if !retrying {
+			// during a Steam Guard retry, Auth logs on with the code itself
+			go a.logOn()
+		}
+	case *steam.LoggedOnEvent:
+		a.mutex.Lock()
+		a.setStateLocked(Online)
+		a.steamId = a.client.SteamId()
+		a.lastError = nil
+		a.logOnFailed = false
+		a.guardRetry = false
+		a.mutex.Unlock()
+	case *steam.LogOnFailedEvent:
+		a.mutex.Lock()
+		a.lastError = &steam.EResultError{Op: "logon", Result: e.Result}
+		a.logOnFailed = true
+		a.guardRetry = false
+		if e.Result == steamlang.EResult_AccessDenied || e.Result == steamlang.EResult_Expired {
+			// Auth has removed the rejected refresh token from the store as well
+			a.details.RefreshToken = ""
+		}
+		a.mutex.Unlock()
+	case *steam.SteamGuardRequiredEvent:
+		a.mutex.Lock()
+		a.guardRetry = e.Retrying
+		a.mutex.Unlock()
+	case *steam.LoginKeyEvent:
+		a.mutex.Lock()
+		if a.details.ShouldRememberPassword {
+			a.details.LoginKey = e.LoginKey
+		}
+		a.mutex.Unlock()
+	case *steam.ClientCMListEvent:
+		a.manager.setServerList(e.Addresses)
+	case *steam.DisconnectedEvent:
+		a.mutex.Lock()
+		if a.ctx.Err() != nil {
+			a.setStateLocked(Stopped)
+		} else if !e.UserInitiated {
+			a.setStateLocked(Reconnecting)
+		} else if a.guardRetry {
+			// Auth reconnects and logs on with a Steam Guard code
+			a.setStateLocked(LoggingOn)
+		} else if a.logOnFailed {
+			// rejected logons are disconnected by the client and not retried
+			a.setStateLocked(Failed)
+		} else {
+			a.setStateLocked(Stopped)
+		}
+		a.mutex.Unlock()
+	case *steam.ReconnectingEvent:
+		a.mutex.Lock()
+		a.setStateLocked(Reconnecting)
+		a.reconnects++
+		a.mutex.Unlock()
+	case *steam.ReconnectFailedEvent:
+		a.mutex.Lock()
+		a.setStateLocked(Failed)
+		a.lastError = e.Err
+		a.mutex.Unlock()
+	case *steam.ConnectionTimeoutEvent:
+		a.setError(fmt.Errorf("manager: connection timed out after %v", e.Idle))
+	case error:
+		a.setError(e)
+	}
+}
+
+func (a *account) setError(err error) {
+	a.mutex.Lock()
+	a.lastError = err
+	a.mutex.Unlock()
+}
@@ -0,0 +1,342 @@
+/*
+Package manager runs many Steam accounts in one process.
+
+Every account gets its own steam.Client. The manager connects it, logs it on and keeps it online with
+the client's reconnect supervisor. Logons of all accounts are spaced out by Options.LogOnInterval so
+that starting many accounts at once doesn't run into Steam's rate limits, and the CM servers one client
+learns about are used by all of them. The events of all clients are merged into one stream, tagged
+with the account they belong to.
+
+	m := manager.New(&manager.Options{
+		// keeps the sentry hashes and refresh tokens of the accounts
+		CredentialStore: steam.NewFileCredentialStore("credentials.json"),
+	})
+	defer m.Close()
+	for _, details := range accounts {
+		m.Add(&manager.Account{Details: details})
+	}
+	for e := range m.Events() {
+		switch event := e.Event.(type) {
+		case *steam.ChatMsgEvent:
+			m.Client(e.Account).Social.SendMessage(event.ChatterId, event.EntryType, "pong")
+		}
+	}
+*/
+package manager
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3"
+	"github.com/Philipp15b/go-steam/v3/netutil"
+)
+
+// Returned by Add if an account with the same name is already managed.
+var ErrAccountExists = errors.New("manager: account already exists")
+
+// Returned if the account isn't managed.
+var ErrUnknownAccount = errors.New("manager: unknown account")
+
+// Returned by Add after the manager has been closed.
+var ErrClosed = errors.New("manager: closed")
+
+// Returned by Add and reported in Status.LastError if neither the details nor the CredentialStore
+// contain a password, login key or refresh token for the account.
+var ErrNoCredentials = errors.New("manager: account has no password, login key or refresh token")
+
+// An account to run.
+type Account struct {
+	// The account name is Details.Username. Steam Guard codes in the details are only used for the first logon.
+	Details *steam.LogOnDetails
+	// If set, the client connects through this proxy, see steam.Client.SetProxy.
+	Proxy string
+	// Called with the new client before it connects, for example to register packet handlers.
+	Configure func(client *steam.Client)
+}
+
+type Options struct {
+	// The minimum time between two logons, over all accounts. Defaults to five seconds.
+	LogOnInterval time.Duration
+	// The CM servers to connect to. If empty, the Steam Directory is used until a client receives a server list.
+	Servers []string
+	// The settings of the reconnect supervisor. ServerList and SkipLogOn are set by the manager.
+	Reconnect steam.ReconnectOptions
+	// The time a connection attempt may take. Defaults to 30 seconds.
+	ConnectTimeout time.Duration
+	// The size of the buffer of Events. Defaults to 100.
+	EventBuffer int
+	// If set, it is used as the CredentialStore of all clients, so that accounts can be
+	// added with only a username once they have logged on before. It also keeps the sentry hashes.
+	CredentialStore steam.CredentialStore
+}
+
+// An event of one of the managed clients.
+type Event struct {
+	Account string
+	Event   interface{}
+}
+
+// Owns the clients of many accounts. All methods are safe for concurrent use.
+type Manager struct {
+	opts    Options
+	limiter *limiter
+
+	events     chan *Event
+	eventsUsed int32
+
+	mutex    sync.RWMutex // guarding accounts, adding, servers and closed
+	accounts map[string]*account
+	adding   map[string]bool // names of accounts that Add is still creating the client for
+	servers  []string
+	closed   bool
+}
+
+// Creates a manager. If opts is nil, the defaults are used.
+func New(opts *Options) *Manager {
+	if opts == nil {
+		opts = new(Options)
+	}
+	o := *opts
+	if o.LogOnInterval <= 0 {
+		o.LogOnInterval = 5 * time.Second
+	}
+	if o.ConnectTimeout <= 0 {
+		o.ConnectTimeout = 30 * time.Second
+	}
+	if o.EventBuffer <= 0 {
+		o.EventBuffer = 100
+	}
+	return &Manager{
+		opts:     o,
+		limiter:  &limiter{interval: o.LogOnInterval},
+		events:   make(chan *Event, o.EventBuffer),
+		accounts: make(map[string]*account),
+		adding:   make(map[string]bool),
+		servers:  append([]string(nil), o.Servers...),
+	}
+}
+
+// Returns the events of all clients. Until this is called for the first time, events are dropped
+// when the buffer is full. Afterwards, the clients block until there is room, like steam.Client.Events.
+// The channel is closed by Close.
+func (m *Manager) Events() <-chan *Event {
+	atomic.StoreInt32(&m.eventsUsed, 1)
+	return m.events
+}
+
+// Forwards an event. Blocking sends are given up when the account is stopped.
+func (m *Manager) emit(ctx context.Context, name string, event interface{}) {
+	e := &Event{Account: name, Event: event}
+	if atomic.LoadInt32(&m.eventsUsed) == 1 {
+		select {
+		case m.events <- e:
+		case <-ctx.Done():
+		}
+		return
+	}
+	select {
+	case m.events <- e:
+	default:
+	}
+}
+
+// Adds an account and starts connecting and logging it on in the background.
+// The client is only created and configured after the name has been reserved, so Account.Configure
+// is not called if the manager is closed or already has an account with that name.
+func (m *Manager) Add(a *Account) error {
+	if a.Details == nil || a.Details.Username == "" {
+		return errors.New("manager: account has no username")
+	}
+	name := a.Details.Username
+	m.mutex.Lock()
+	if m.closed {
+		m.mutex.Unlock()
+		return ErrClosed
+	}
+	if _, ok := m.accounts[name]; ok || m.adding[name] {
+		m.mutex.Unlock()
+		return ErrAccountExists
+	}
+	m.adding[name] = true
+	m.mutex.Unlock()
+
+	client, err := m.newClient(a)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.adding, name)
+	if err != nil {
+		return err
+	}
+	if m.closed {
+		return ErrClosed
+	}
+	acc := newAccount(m, a, client)
+	m.accounts[name] = acc
+	acc.start()
+	return nil
+}
+
+func (m *Manager) newClient(a *Account) (*steam.Client, error) {
+	client := steam.NewClient()
+	client.IgnoreEvents()
+	client.CredentialStore = m.opts.CredentialStore
+	if !client.Auth.HasCredentials(a.Details) {
+		return nil, ErrNoCredentials
+	}
+	if a.Proxy != "" {
+		if err := client.SetProxy(a.Proxy); err != nil {
+			return nil, err
+		}
+	}
+	if a.Configure != nil {
+		a.Configure(client)
+	}
+	return client, nil
+}
+
+// Logs an account off, disconnects it and stops managing it.
+func (m *Manager) Remove(name string) error {
+	m.mutex.Lock()
+	acc, ok := m.accounts[name]
+	delete(m.accounts, name)
+	m.mutex.Unlock()
+	if !ok {
+		return ErrUnknownAccount
+	}
+	acc.stop()
+	return nil
+}
+
+// Returns the client of an account, or nil if the account isn't managed.
+func (m *Manager) Client(name string) *steam.Client {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	if acc, ok := m.accounts[name]; ok {
+		return acc.client
+	}
+	return nil
+}
+
+// Returns the names of all accounts, sorted.
+func (m *Manager) Accounts() []string {
+	m.mutex.RLock()
+	names := make([]string, 0, len(m.accounts))
+	for name := range m.accounts {
+		names = append(names, name)
+	}
+	m.mutex.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
+// Returns the status of an account.
+func (m *Manager) Status(name string) (Status, error) {
+	m.mutex.RLock()
+	acc, ok := m.accounts[name]
+	m.mutex.RUnlock()
+	if !ok {
+		return Status{}, ErrUnknownAccount
+	}
+	return acc.status(), nil
+}
+
+// Returns the status of all accounts, sorted by name.
+func (m *Manager) Statuses() []Status {
+	m.mutex.RLock()
+	statuses := make([]Status, 0, len(m.accounts))
+	for _, acc := range m.accounts {
+		statuses = append(statuses, acc.status())
+	}
+	m.mutex.RUnlock()
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Account < statuses[j].Account
+	})
+	return statuses
+}
+
+// Logs all accounts off, waits for them to disconnect and closes the Events channel.
+func (m *Manager) Close() {
+	m.mutex.Lock()
+	if m.closed {
+		m.mutex.Unlock()
+		return
+	}
+	m.closed = true
+	accounts := m.accounts
+	m.accounts = make(map[string]*account)
+	m.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	for _, acc := range accounts {
+		wg.Add(1)
+		go func(acc *account) {
+			defer wg.Done()
+			acc.stop()
+		}(acc)
+	}
+	wg.Wait()
+	close(m.events)
+}
+
+// Returns a copy of the shared server list.
+func (m *Manager) serverList() []string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return append([]string(nil), m.servers...)
+}
+
+func (m *Manager) setServerList(addresses []*netutil.PortAddr) {
+	servers := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		servers = append(servers, addr.String())
+	}
+	if len(servers) == 0 {
+		return
+	}
+	m.mutex.Lock()
+	m.servers = servers
+	m.mutex.Unlock()
+}
+
+func (m *Manager) randomServer() *netutil.PortAddr {
+	servers := m.serverList()
+	if len(servers) == 0 {
+		return nil
+	}
+	return netutil.ParsePortAddr(servers[rand.Intn(len(servers))])
+}
+
+// Spaces out logons: every call to wait returns at least interval after the previous one.
+type limiter struct {
+	interval time.Duration
+
+	mutex sync.Mutex
+	next  time.Time
+}
+
+func (l *limiter) wait(ctx context.Context) error {
+	l.mutex.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mutex.Unlock()
+
+	timer := time.NewTimer(slot.Sub(now))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
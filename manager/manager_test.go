@@ -0,0 +1,207 @@
+package manager
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3"
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamtest"
+	"google.golang.org/protobuf/proto"
+)
+
+// Rejects the logons of mallory and accepts everyone else.
+func handleLogOn(conn *steamtest.Conn, packet *protocol.Packet) {
+	logon := new(protobuf.CMsgClientLogon)
+	packet.ReadProtoMsg(logon)
+	result := steamlang.EResult_OK
+	if logon.GetAccountName() == "mallory" {
+		result = steamlang.EResult_InvalidPassword
+	}
+	conn.SendProto(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+		Eresult:                   proto.Int32(int32(result)),
+		OutOfGameHeartbeatSeconds: proto.Int32(9),
+	})
+}
+
+func TestManager(t *testing.T) {
+	server := steamtest.NewServer()
+	defer server.Close()
+	server.Handle(steamlang.EMsg_ClientLogon, handleLogOn)
+
+	interval := 300 * time.Millisecond
+	m := New(&Options{
+		LogOnInterval: interval,
+		Servers:       []string{server.Addr().String()},
+	})
+	events := m.Events()
+	for _, name := range []string{"gopher", "gordon", "mallory"} {
+		if err := m.Add(&Account{
+			Details:   &steam.LogOnDetails{Username: name, Password: "hunter2"},
+			Configure: server.Configure,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.Add(&Account{Details: &steam.LogOnDetails{Username: "gopher", Password: "hunter2"}}); err != ErrAccountExists {
+		t.Fatalf("Expected ErrAccountExists, got %v", err)
+	}
+
+	loggedOn := make(map[string]time.Time)
+	failed := ""
+	timeout := time.After(5 * time.Second)
+	for len(loggedOn) < 2 || failed == "" {
+		select {
+		case e := <-events:
+			switch event := e.Event.(type) {
+			case *steam.LoggedOnEvent:
+				loggedOn[e.Account] = time.Now()
+			case *steam.LogOnFailedEvent:
+				if event.Result != steamlang.EResult_InvalidPassword {
+					t.Fatalf("Unexpected logon result %v", event.Result)
+				}
+				failed = e.Account
+			}
+		case <-timeout:
+			t.Fatalf("Timed out, logged on: %v, failed: %q", loggedOn, failed)
+		}
+	}
+	if failed != "mallory" {
+		t.Fatalf("Expected mallory to fail, got %v", failed)
+	}
+	gap := loggedOn["gopher"].Sub(loggedOn["gordon"])
+	if gap < 0 {
+		gap = -gap
+	}
+	// allow for some scheduling jitter
+	if gap < interval*2/3 {
+		t.Fatalf("Logons were only %v apart, expected %v", gap, interval)
+	}
+
+	for _, name := range []string{"gopher", "gordon"} {
+		status, err := m.Status(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.State != Online || status.SteamId != steamtest.DefaultSteamId || status.LastError != nil {
+			t.Fatalf("Unexpected status %+v", status)
+		}
+	}
+	waitForState(t, m, "mallory", Failed)
+
+	if err := m.Remove("gopher"); err != nil {
+		t.Fatal(err)
+	}
+	if m.Client("gopher") != nil {
+		t.Fatal("Expected gopher to be removed")
+	}
+	if accounts := m.Accounts(); len(accounts) != 2 || accounts[0] != "gordon" || accounts[1] != "mallory" {
+		t.Fatalf("Unexpected accounts %v", accounts)
+	}
+
+	go m.Close()
+	for range events {
+	}
+}
+
+func waitForState(t *testing.T, m *Manager, name string, state State) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status, err := m.Status(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.State == state {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %v to be %v, got %+v", name, state, status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestAddWithoutCredentials(t *testing.T) {
+	m := New(&Options{CredentialStore: steam.NewMemoryCredentialStore()})
+	defer m.Close()
+	if err := m.Add(&Account{Details: &steam.LogOnDetails{Username: "gopher"}}); err != ErrNoCredentials {
+		t.Fatalf("Expected ErrNoCredentials for an account that isn't in the store, got %v", err)
+	}
+}
+
+func TestAddReservesName(t *testing.T) {
+	m := New(nil)
+	details := &steam.LogOnDetails{Username: "gopher", Password: "hunter2"}
+	configured := 0
+	err := m.Add(&Account{
+		Details: details,
+		Configure: func(*steam.Client) {
+			configured++
+			if err := m.Add(&Account{Details: details, Configure: func(*steam.Client) { configured++ }}); err != ErrAccountExists {
+				t.Errorf("Expected ErrAccountExists while the account is added, got %v", err)
+			}
+			m.Close()
+		},
+	})
+	if err != ErrClosed {
+		t.Fatalf("Expected ErrClosed for an account added during Close, got %v", err)
+	}
+	if err = m.Add(&Account{Details: details, Configure: func(*steam.Client) { configured++ }}); err != ErrClosed {
+		t.Fatalf("Expected ErrClosed, got %v", err)
+	}
+	if configured != 1 {
+		t.Fatalf("Expected Configure to be called once, got %v", configured)
+	}
+	if len(m.Accounts()) != 0 {
+		t.Fatalf("Expected no accounts, got %v", m.Accounts())
+	}
+}
+
+func TestGuardRetry(t *testing.T) {
+	server := steamtest.NewServer()
+	defer server.Close()
+	var logons int32
+	server.Handle(steamlang.EMsg_ClientLogon, func(conn *steamtest.Conn, packet *protocol.Packet) {
+		logon := new(protobuf.CMsgClientLogon)
+		packet.ReadProtoMsg(logon)
+		atomic.AddInt32(&logons, 1)
+		result := steamlang.EResult_AccountLoginDeniedNeedTwoFactor
+		if logon.GetTwoFactorCode() == "ABCDE" {
+			result = steamlang.EResult_OK
+		}
+		conn.SendProto(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+			Eresult:                   proto.Int32(int32(result)),
+			OutOfGameHeartbeatSeconds: proto.Int32(9),
+		})
+	})
+
+	interval := 200 * time.Millisecond
+	m := New(&Options{
+		LogOnInterval: interval,
+		Servers:       []string{server.Addr().String()},
+	})
+	defer m.Close()
+	err := m.Add(&Account{
+		Details: &steam.LogOnDetails{
+			Username: "gopher",
+			Password: "hunter2",
+			GuardCodeProvider: func(*steam.SteamGuardRequiredEvent) (string, error) {
+				return "ABCDE", nil
+			},
+		},
+		Configure: server.Configure,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForState(t, m, "gopher", Online)
+
+	// the logon after the reconnect for the retry must not be followed by another one
+	time.Sleep(2 * interval)
+	if n := atomic.LoadInt32(&logons); n != 2 {
+		t.Fatalf("Expected two logons, got %v", n)
+	}
+}
@@ -0,0 +1,180 @@
+package netutil
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Starts a listener that echoes everything back and returns its address.
+func echoServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+// Starts a proxy that handles every connection with serve.
+func proxyServer(t *testing.T, serve func(conn net.Conn)) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				serve(conn)
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func relay(client io.ReadWriter, target string) {
+	conn, err := net.Dial("tcp", target)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	go io.Copy(conn, client)
+	io.Copy(client, conn)
+}
+
+// A minimal SOCKS5 server that requires the given credentials and only supports IPv4 addresses.
+func serveSOCKS5(username, password string) func(conn net.Conn) {
+	return func(conn net.Conn) {
+		buf := make([]byte, 262)
+		if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+			return
+		}
+		methods := buf[2 : 2+buf[1]]
+		if _, err := io.ReadFull(conn, methods); err != nil {
+			return
+		}
+		conn.Write([]byte{socks5Version, socks5AuthPassword})
+
+		io.ReadFull(conn, buf[:2])
+		user := make([]byte, buf[1])
+		io.ReadFull(conn, user)
+		io.ReadFull(conn, buf[:1])
+		pass := make([]byte, buf[0])
+		io.ReadFull(conn, pass)
+		if string(user) != username || string(pass) != password {
+			conn.Write([]byte{0x01, 0x01})
+			return
+		}
+		conn.Write([]byte{0x01, 0x00})
+
+		if _, err := io.ReadFull(conn, buf[:4+net.IPv4len+2]); err != nil || buf[3] != socks5IPv4 {
+			return
+		}
+		ip := net.IP(buf[4 : 4+net.IPv4len])
+		port := binary.BigEndian.Uint16(buf[4+net.IPv4len:])
+		conn.Write([]byte{socks5Version, 0, 0, socks5IPv4, 0, 0, 0, 0, 0, 0})
+		relay(conn, net.JoinHostPort(ip.String(), strconv.Itoa(int(port))))
+	}
+}
+
+func serveHTTPConnect(authorization string) func(conn net.Conn) {
+	return func(conn net.Conn) {
+		reader := bufio.NewReader(conn)
+		req, err := http.ReadRequest(reader)
+		if err != nil || req.Method != "CONNECT" {
+			return
+		}
+		if req.Header.Get("Proxy-Authorization") != authorization {
+			conn.Write([]byte("HTTP/1.1 407 Proxy Authentication Required\r\n\r\n"))
+			return
+		}
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		relay(conn, req.Host)
+	}
+}
+
+func testEcho(t *testing.T, dialer Dialer, target string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := dialer.DialContext(ctx, "tcp", target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	if _, err = conn.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 4)
+	if _, err = io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "ping" {
+		t.Fatalf("expected ping, got %q", buf)
+	}
+}
+
+func TestSOCKS5Dialer(t *testing.T) {
+	target := echoServer(t)
+	proxy := proxyServer(t, serveSOCKS5("user", "secret"))
+
+	dialer, err := NewProxyDialer(&url.URL{Scheme: "socks5", Host: proxy, User: url.UserPassword("user", "secret")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEcho(t, dialer, target)
+
+	wrong := NewSOCKS5Dialer(proxy, nil)
+	wrong.Username, wrong.Password = "user", "wrong"
+	if _, err = wrong.DialContext(context.Background(), "tcp", target); err == nil {
+		t.Fatal("expected an error for wrong credentials")
+	}
+}
+
+func TestHTTPConnectDialer(t *testing.T) {
+	target := echoServer(t)
+	// base64("user:secret")
+	proxy := proxyServer(t, serveHTTPConnect("Basic dXNlcjpzZWNyZXQ="))
+
+	dialer, err := NewProxyDialer(&url.URL{Scheme: "http", Host: proxy, User: url.UserPassword("user", "secret")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEcho(t, dialer, target)
+
+	if _, err = NewHTTPConnectDialer(proxy, nil).DialContext(context.Background(), "tcp", target); err == nil {
+		t.Fatal("expected an error without credentials")
+	}
+}
+
+func TestNewProxyDialerUnsupportedScheme(t *testing.T) {
+	if _, err := NewProxyDialer(&url.URL{Scheme: "ftp", Host: "127.0.0.1:21"}, nil); err == nil {
+		t.Fatal("expected an error")
+	}
+}
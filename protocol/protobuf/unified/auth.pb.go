@@ -0,0 +1,2470 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.1
+// source: steammessages_auth.steamclient.proto
+
+package unified
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EAuthTokenPlatformType int32
+
+const (
+	EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown     EAuthTokenPlatformType = 0
+	EAuthTokenPlatformType_k_EAuthTokenPlatformType_SteamClient EAuthTokenPlatformType = 1
+	EAuthTokenPlatformType_k_EAuthTokenPlatformType_WebBrowser  EAuthTokenPlatformType = 2
+	EAuthTokenPlatformType_k_EAuthTokenPlatformType_MobileApp   EAuthTokenPlatformType = 3
+)
+
+// Enum value maps for EAuthTokenPlatformType.
+var (
+	EAuthTokenPlatformType_name = map[int32]string{
+		0: "k_EAuthTokenPlatformType_Unknown",
+		1: "k_EAuthTokenPlatformType_SteamClient",
+		2: "k_EAuthTokenPlatformType_WebBrowser",
+		3: "k_EAuthTokenPlatformType_MobileApp",
+	}
+	EAuthTokenPlatformType_value = map[string]int32{
+		"k_EAuthTokenPlatformType_Unknown":     0,
+		"k_EAuthTokenPlatformType_SteamClient": 1,
+		"k_EAuthTokenPlatformType_WebBrowser":  2,
+		"k_EAuthTokenPlatformType_MobileApp":   3,
+	}
+)
+
+func (x EAuthTokenPlatformType) Enum() *EAuthTokenPlatformType {
+	p := new(EAuthTokenPlatformType)
+	*p = x
+	return p
+}
+
+func (x EAuthTokenPlatformType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthTokenPlatformType) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[0].Descriptor()
+}
+
+func (EAuthTokenPlatformType) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[0]
+}
+
+func (x EAuthTokenPlatformType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthTokenPlatformType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthTokenPlatformType(num)
+	return nil
+}
+
+// Deprecated: Use EAuthTokenPlatformType.Descriptor instead.
+func (EAuthTokenPlatformType) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+type EAuthSessionGuardType int32
+
+const (
+	EAuthSessionGuardType_k_EAuthSessionGuardType_Unknown            EAuthSessionGuardType = 0
+	EAuthSessionGuardType_k_EAuthSessionGuardType_None               EAuthSessionGuardType = 1
+	EAuthSessionGuardType_k_EAuthSessionGuardType_EmailCode          EAuthSessionGuardType = 2
+	EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceCode         EAuthSessionGuardType = 3
+	EAuthSessionGuardType_k_EAuthSessionGuardType_DeviceConfirmation EAuthSessionGuardType = 4
+	EAuthSessionGuardType_k_EAuthSessionGuardType_EmailConfirmation  EAuthSessionGuardType = 5
+	EAuthSessionGuardType_k_EAuthSessionGuardType_MachineToken       EAuthSessionGuardType = 6
+	EAuthSessionGuardType_k_EAuthSessionGuardType_LegacyMachineAuth  EAuthSessionGuardType = 7
+)
+
+// Enum value maps for EAuthSessionGuardType.
+var (
+	EAuthSessionGuardType_name = map[int32]string{
+		0: "k_EAuthSessionGuardType_Unknown",
+		1: "k_EAuthSessionGuardType_None",
+		2: "k_EAuthSessionGuardType_EmailCode",
+		3: "k_EAuthSessionGuardType_DeviceCode",
+		4: "k_EAuthSessionGuardType_DeviceConfirmation",
+		5: "k_EAuthSessionGuardType_EmailConfirmation",
+		6: "k_EAuthSessionGuardType_MachineToken",
+		7: "k_EAuthSessionGuardType_LegacyMachineAuth",
+	}
+	EAuthSessionGuardType_value = map[string]int32{
+		"k_EAuthSessionGuardType_Unknown":            0,
+		"k_EAuthSessionGuardType_None":               1,
+		"k_EAuthSessionGuardType_EmailCode":          2,
+		"k_EAuthSessionGuardType_DeviceCode":         3,
+		"k_EAuthSessionGuardType_DeviceConfirmation": 4,
+		"k_EAuthSessionGuardType_EmailConfirmation":  5,
+		"k_EAuthSessionGuardType_MachineToken":       6,
+		"k_EAuthSessionGuardType_LegacyMachineAuth":  7,
+	}
+)
+
+func (x EAuthSessionGuardType) Enum() *EAuthSessionGuardType {
+	p := new(EAuthSessionGuardType)
+	*p = x
+	return p
+}
+
+func (x EAuthSessionGuardType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthSessionGuardType) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[1].Descriptor()
+}
+
+func (EAuthSessionGuardType) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[1]
+}
+
+func (x EAuthSessionGuardType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthSessionGuardType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthSessionGuardType(num)
+	return nil
+}
+
+// Deprecated: Use EAuthSessionGuardType.Descriptor instead.
+func (EAuthSessionGuardType) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+type EAuthSessionSecurityHistory int32
+
+const (
+	EAuthSessionSecurityHistory_k_EAuthSessionSecurityHistory_Invalid        EAuthSessionSecurityHistory = 0
+	EAuthSessionSecurityHistory_k_EAuthSessionSecurityHistory_UsedPreviously EAuthSessionSecurityHistory = 1
+	EAuthSessionSecurityHistory_k_EAuthSessionSecurityHistory_NoPriorHistory EAuthSessionSecurityHistory = 2
+)
+
+// Enum value maps for EAuthSessionSecurityHistory.
+var (
+	EAuthSessionSecurityHistory_name = map[int32]string{
+		0: "k_EAuthSessionSecurityHistory_Invalid",
+		1: "k_EAuthSessionSecurityHistory_UsedPreviously",
+		2: "k_EAuthSessionSecurityHistory_NoPriorHistory",
+	}
+	EAuthSessionSecurityHistory_value = map[string]int32{
+		"k_EAuthSessionSecurityHistory_Invalid":        0,
+		"k_EAuthSessionSecurityHistory_UsedPreviously": 1,
+		"k_EAuthSessionSecurityHistory_NoPriorHistory": 2,
+	}
+)
+
+func (x EAuthSessionSecurityHistory) Enum() *EAuthSessionSecurityHistory {
+	p := new(EAuthSessionSecurityHistory)
+	*p = x
+	return p
+}
+
+func (x EAuthSessionSecurityHistory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAuthSessionSecurityHistory) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[2].Descriptor()
+}
+
+func (EAuthSessionSecurityHistory) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[2]
+}
+
+func (x EAuthSessionSecurityHistory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EAuthSessionSecurityHistory) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EAuthSessionSecurityHistory(num)
+	return nil
+}
+
+// Deprecated: Use EAuthSessionSecurityHistory.Descriptor instead.
+func (EAuthSessionSecurityHistory) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+type ESessionPersistence int32
+
+const (
+	ESessionPersistence_k_ESessionPersistence_Invalid    ESessionPersistence = -1
+	ESessionPersistence_k_ESessionPersistence_Ephemeral  ESessionPersistence = 0
+	ESessionPersistence_k_ESessionPersistence_Persistent ESessionPersistence = 1
+)
+
+// Enum value maps for ESessionPersistence.
+var (
+	ESessionPersistence_name = map[int32]string{
+		-1: "k_ESessionPersistence_Invalid",
+		0:  "k_ESessionPersistence_Ephemeral",
+		1:  "k_ESessionPersistence_Persistent",
+	}
+	ESessionPersistence_value = map[string]int32{
+		"k_ESessionPersistence_Invalid":    -1,
+		"k_ESessionPersistence_Ephemeral":  0,
+		"k_ESessionPersistence_Persistent": 1,
+	}
+)
+
+func (x ESessionPersistence) Enum() *ESessionPersistence {
+	p := new(ESessionPersistence)
+	*p = x
+	return p
+}
+
+func (x ESessionPersistence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ESessionPersistence) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[3].Descriptor()
+}
+
+func (ESessionPersistence) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[3]
+}
+
+func (x ESessionPersistence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ESessionPersistence) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ESessionPersistence(num)
+	return nil
+}
+
+// Deprecated: Use ESessionPersistence.Descriptor instead.
+func (ESessionPersistence) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+type ETokenRenewalType int32
+
+const (
+	ETokenRenewalType_k_ETokenRenewalType_None  ETokenRenewalType = 0
+	ETokenRenewalType_k_ETokenRenewalType_Allow ETokenRenewalType = 1
+)
+
+// Enum value maps for ETokenRenewalType.
+var (
+	ETokenRenewalType_name = map[int32]string{
+		0: "k_ETokenRenewalType_None",
+		1: "k_ETokenRenewalType_Allow",
+	}
+	ETokenRenewalType_value = map[string]int32{
+		"k_ETokenRenewalType_None":  0,
+		"k_ETokenRenewalType_Allow": 1,
+	}
+)
+
+func (x ETokenRenewalType) Enum() *ETokenRenewalType {
+	p := new(ETokenRenewalType)
+	*p = x
+	return p
+}
+
+func (x ETokenRenewalType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ETokenRenewalType) Descriptor() protoreflect.EnumDescriptor {
+	return file_steammessages_auth_steamclient_proto_enumTypes[4].Descriptor()
+}
+
+func (ETokenRenewalType) Type() protoreflect.EnumType {
+	return &file_steammessages_auth_steamclient_proto_enumTypes[4]
+}
+
+func (x ETokenRenewalType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ETokenRenewalType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ETokenRenewalType(num)
+	return nil
+}
+
+// Deprecated: Use ETokenRenewalType.Descriptor instead.
+func (ETokenRenewalType) EnumDescriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+type CAuthentication_GetPasswordRSAPublicKey_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountName *string `protobuf:"bytes,1,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Request) Reset() {
+	*x = CAuthentication_GetPasswordRSAPublicKey_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetPasswordRSAPublicKey_Request) ProtoMessage() {}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetPasswordRSAPublicKey_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetPasswordRSAPublicKey_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Request) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+type CAuthentication_GetPasswordRSAPublicKey_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublickeyMod *string `protobuf:"bytes,1,opt,name=publickey_mod,json=publickeyMod" json:"publickey_mod,omitempty"`
+	PublickeyExp *string `protobuf:"bytes,2,opt,name=publickey_exp,json=publickeyExp" json:"publickey_exp,omitempty"`
+	Timestamp    *uint64 `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) Reset() {
+	*x = CAuthentication_GetPasswordRSAPublicKey_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetPasswordRSAPublicKey_Response) ProtoMessage() {}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetPasswordRSAPublicKey_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetPasswordRSAPublicKey_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) GetPublickeyMod() string {
+	if x != nil && x.PublickeyMod != nil {
+		return *x.PublickeyMod
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) GetPublickeyExp() string {
+	if x != nil && x.PublickeyExp != nil {
+		return *x.PublickeyExp
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetPasswordRSAPublicKey_Response) GetTimestamp() uint64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+type CAuthentication_DeviceDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceFriendlyName *string                 `protobuf:"bytes,1,opt,name=device_friendly_name,json=deviceFriendlyName" json:"device_friendly_name,omitempty"`
+	PlatformType       *EAuthTokenPlatformType `protobuf:"varint,2,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	OsType             *int32                  `protobuf:"varint,3,opt,name=os_type,json=osType" json:"os_type,omitempty"`
+	GamingDeviceType   *uint32                 `protobuf:"varint,4,opt,name=gaming_device_type,json=gamingDeviceType" json:"gaming_device_type,omitempty"`
+}
+
+// Default values for CAuthentication_DeviceDetails fields.
+const (
+	Default_CAuthentication_DeviceDetails_PlatformType = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+)
+
+func (x *CAuthentication_DeviceDetails) Reset() {
+	*x = CAuthentication_DeviceDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_DeviceDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_DeviceDetails) ProtoMessage() {}
+
+func (x *CAuthentication_DeviceDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_DeviceDetails.ProtoReflect.Descriptor instead.
+func (*CAuthentication_DeviceDetails) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CAuthentication_DeviceDetails) GetDeviceFriendlyName() string {
+	if x != nil && x.DeviceFriendlyName != nil {
+		return *x.DeviceFriendlyName
+	}
+	return ""
+}
+
+func (x *CAuthentication_DeviceDetails) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_DeviceDetails_PlatformType
+}
+
+func (x *CAuthentication_DeviceDetails) GetOsType() int32 {
+	if x != nil && x.OsType != nil {
+		return *x.OsType
+	}
+	return 0
+}
+
+func (x *CAuthentication_DeviceDetails) GetGamingDeviceType() uint32 {
+	if x != nil && x.GamingDeviceType != nil {
+		return *x.GamingDeviceType
+	}
+	return 0
+}
+
+type CAuthentication_BeginAuthSessionViaQR_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceFriendlyName *string                        `protobuf:"bytes,1,opt,name=device_friendly_name,json=deviceFriendlyName" json:"device_friendly_name,omitempty"`
+	PlatformType       *EAuthTokenPlatformType        `protobuf:"varint,2,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	DeviceDetails      *CAuthentication_DeviceDetails `protobuf:"bytes,3,opt,name=device_details,json=deviceDetails" json:"device_details,omitempty"`
+	WebsiteId          *string                        `protobuf:"bytes,4,opt,name=website_id,json=websiteId,def=Unknown" json:"website_id,omitempty"`
+}
+
+// Default values for CAuthentication_BeginAuthSessionViaQR_Request fields.
+const (
+	Default_CAuthentication_BeginAuthSessionViaQR_Request_PlatformType = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+	Default_CAuthentication_BeginAuthSessionViaQR_Request_WebsiteId    = string("Unknown")
+)
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) Reset() {
+	*x = CAuthentication_BeginAuthSessionViaQR_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_BeginAuthSessionViaQR_Request) ProtoMessage() {}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_BeginAuthSessionViaQR_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_BeginAuthSessionViaQR_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) GetDeviceFriendlyName() string {
+	if x != nil && x.DeviceFriendlyName != nil {
+		return *x.DeviceFriendlyName
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_BeginAuthSessionViaQR_Request_PlatformType
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) GetDeviceDetails() *CAuthentication_DeviceDetails {
+	if x != nil {
+		return x.DeviceDetails
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Request) GetWebsiteId() string {
+	if x != nil && x.WebsiteId != nil {
+		return *x.WebsiteId
+	}
+	return Default_CAuthentication_BeginAuthSessionViaQR_Request_WebsiteId
+}
+
+type CAuthentication_AllowedConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfirmationType  *EAuthSessionGuardType `protobuf:"varint,1,opt,name=confirmation_type,json=confirmationType,enum=EAuthSessionGuardType,def=0" json:"confirmation_type,omitempty"`
+	AssociatedMessage *string                `protobuf:"bytes,2,opt,name=associated_message,json=associatedMessage" json:"associated_message,omitempty"`
+}
+
+// Default values for CAuthentication_AllowedConfirmation fields.
+const (
+	Default_CAuthentication_AllowedConfirmation_ConfirmationType = EAuthSessionGuardType_k_EAuthSessionGuardType_Unknown
+)
+
+func (x *CAuthentication_AllowedConfirmation) Reset() {
+	*x = CAuthentication_AllowedConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_AllowedConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_AllowedConfirmation) ProtoMessage() {}
+
+func (x *CAuthentication_AllowedConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_AllowedConfirmation.ProtoReflect.Descriptor instead.
+func (*CAuthentication_AllowedConfirmation) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CAuthentication_AllowedConfirmation) GetConfirmationType() EAuthSessionGuardType {
+	if x != nil && x.ConfirmationType != nil {
+		return *x.ConfirmationType
+	}
+	return Default_CAuthentication_AllowedConfirmation_ConfirmationType
+}
+
+func (x *CAuthentication_AllowedConfirmation) GetAssociatedMessage() string {
+	if x != nil && x.AssociatedMessage != nil {
+		return *x.AssociatedMessage
+	}
+	return ""
+}
+
+type CAuthentication_BeginAuthSessionViaQR_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId             *uint64                                `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	ChallengeUrl         *string                                `protobuf:"bytes,2,opt,name=challenge_url,json=challengeUrl" json:"challenge_url,omitempty"`
+	RequestId            []byte                                 `protobuf:"bytes,3,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	Interval             *float32                               `protobuf:"fixed32,4,opt,name=interval" json:"interval,omitempty"`
+	AllowedConfirmations []*CAuthentication_AllowedConfirmation `protobuf:"bytes,5,rep,name=allowed_confirmations,json=allowedConfirmations" json:"allowed_confirmations,omitempty"`
+	Version              *int32                                 `protobuf:"varint,6,opt,name=version" json:"version,omitempty"`
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) Reset() {
+	*x = CAuthentication_BeginAuthSessionViaQR_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_BeginAuthSessionViaQR_Response) ProtoMessage() {}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_BeginAuthSessionViaQR_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_BeginAuthSessionViaQR_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetChallengeUrl() string {
+	if x != nil && x.ChallengeUrl != nil {
+		return *x.ChallengeUrl
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetInterval() float32 {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetAllowedConfirmations() []*CAuthentication_AllowedConfirmation {
+	if x != nil {
+		return x.AllowedConfirmations
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaQR_Response) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type CAuthentication_BeginAuthSessionViaCredentials_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceFriendlyName  *string                        `protobuf:"bytes,1,opt,name=device_friendly_name,json=deviceFriendlyName" json:"device_friendly_name,omitempty"`
+	AccountName         *string                        `protobuf:"bytes,2,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	EncryptedPassword   *string                        `protobuf:"bytes,3,opt,name=encrypted_password,json=encryptedPassword" json:"encrypted_password,omitempty"`
+	EncryptionTimestamp *uint64                        `protobuf:"varint,4,opt,name=encryption_timestamp,json=encryptionTimestamp" json:"encryption_timestamp,omitempty"`
+	RememberLogin       *bool                          `protobuf:"varint,5,opt,name=remember_login,json=rememberLogin" json:"remember_login,omitempty"`
+	PlatformType        *EAuthTokenPlatformType        `protobuf:"varint,6,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	Persistence         *ESessionPersistence           `protobuf:"varint,7,opt,name=persistence,enum=ESessionPersistence,def=1" json:"persistence,omitempty"`
+	WebsiteId           *string                        `protobuf:"bytes,8,opt,name=website_id,json=websiteId,def=Unknown" json:"website_id,omitempty"`
+	DeviceDetails       *CAuthentication_DeviceDetails `protobuf:"bytes,9,opt,name=device_details,json=deviceDetails" json:"device_details,omitempty"`
+	GuardData           *string                        `protobuf:"bytes,10,opt,name=guard_data,json=guardData" json:"guard_data,omitempty"`
+	Language            *uint32                        `protobuf:"varint,11,opt,name=language" json:"language,omitempty"`
+	QosLevel            *int32                         `protobuf:"varint,12,opt,name=qos_level,json=qosLevel,def=2" json:"qos_level,omitempty"`
+}
+
+// Default values for CAuthentication_BeginAuthSessionViaCredentials_Request fields.
+const (
+	Default_CAuthentication_BeginAuthSessionViaCredentials_Request_PlatformType = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+	Default_CAuthentication_BeginAuthSessionViaCredentials_Request_Persistence  = ESessionPersistence_k_ESessionPersistence_Persistent
+	Default_CAuthentication_BeginAuthSessionViaCredentials_Request_WebsiteId    = string("Unknown")
+	Default_CAuthentication_BeginAuthSessionViaCredentials_Request_QosLevel     = int32(2)
+)
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) Reset() {
+	*x = CAuthentication_BeginAuthSessionViaCredentials_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_BeginAuthSessionViaCredentials_Request) ProtoMessage() {}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_BeginAuthSessionViaCredentials_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_BeginAuthSessionViaCredentials_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetDeviceFriendlyName() string {
+	if x != nil && x.DeviceFriendlyName != nil {
+		return *x.DeviceFriendlyName
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetEncryptedPassword() string {
+	if x != nil && x.EncryptedPassword != nil {
+		return *x.EncryptedPassword
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetEncryptionTimestamp() uint64 {
+	if x != nil && x.EncryptionTimestamp != nil {
+		return *x.EncryptionTimestamp
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetRememberLogin() bool {
+	if x != nil && x.RememberLogin != nil {
+		return *x.RememberLogin
+	}
+	return false
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_BeginAuthSessionViaCredentials_Request_PlatformType
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetPersistence() ESessionPersistence {
+	if x != nil && x.Persistence != nil {
+		return *x.Persistence
+	}
+	return Default_CAuthentication_BeginAuthSessionViaCredentials_Request_Persistence
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetWebsiteId() string {
+	if x != nil && x.WebsiteId != nil {
+		return *x.WebsiteId
+	}
+	return Default_CAuthentication_BeginAuthSessionViaCredentials_Request_WebsiteId
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetDeviceDetails() *CAuthentication_DeviceDetails {
+	if x != nil {
+		return x.DeviceDetails
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetGuardData() string {
+	if x != nil && x.GuardData != nil {
+		return *x.GuardData
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetLanguage() uint32 {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Request) GetQosLevel() int32 {
+	if x != nil && x.QosLevel != nil {
+		return *x.QosLevel
+	}
+	return Default_CAuthentication_BeginAuthSessionViaCredentials_Request_QosLevel
+}
+
+type CAuthentication_BeginAuthSessionViaCredentials_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId             *uint64                                `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	RequestId            []byte                                 `protobuf:"bytes,2,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	Interval             *float32                               `protobuf:"fixed32,3,opt,name=interval" json:"interval,omitempty"`
+	AllowedConfirmations []*CAuthentication_AllowedConfirmation `protobuf:"bytes,4,rep,name=allowed_confirmations,json=allowedConfirmations" json:"allowed_confirmations,omitempty"`
+	Steamid              *uint64                                `protobuf:"varint,5,opt,name=steamid" json:"steamid,omitempty"`
+	WeakToken            *string                                `protobuf:"bytes,6,opt,name=weak_token,json=weakToken" json:"weak_token,omitempty"`
+	AgreementSessionUrl  *string                                `protobuf:"bytes,7,opt,name=agreement_session_url,json=agreementSessionUrl" json:"agreement_session_url,omitempty"`
+	ExtendedErrorMessage *string                                `protobuf:"bytes,8,opt,name=extended_error_message,json=extendedErrorMessage" json:"extended_error_message,omitempty"`
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) Reset() {
+	*x = CAuthentication_BeginAuthSessionViaCredentials_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_BeginAuthSessionViaCredentials_Response) ProtoMessage() {}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_BeginAuthSessionViaCredentials_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_BeginAuthSessionViaCredentials_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetInterval() float32 {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetAllowedConfirmations() []*CAuthentication_AllowedConfirmation {
+	if x != nil {
+		return x.AllowedConfirmations
+	}
+	return nil
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetWeakToken() string {
+	if x != nil && x.WeakToken != nil {
+		return *x.WeakToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetAgreementSessionUrl() string {
+	if x != nil && x.AgreementSessionUrl != nil {
+		return *x.AgreementSessionUrl
+	}
+	return ""
+}
+
+func (x *CAuthentication_BeginAuthSessionViaCredentials_Response) GetExtendedErrorMessage() string {
+	if x != nil && x.ExtendedErrorMessage != nil {
+		return *x.ExtendedErrorMessage
+	}
+	return ""
+}
+
+type CAuthentication_PollAuthSessionStatus_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId      *uint64 `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	RequestId     []byte  `protobuf:"bytes,2,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	TokenToRevoke *uint64 `protobuf:"fixed64,3,opt,name=token_to_revoke,json=tokenToRevoke" json:"token_to_revoke,omitempty"`
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) Reset() {
+	*x = CAuthentication_PollAuthSessionStatus_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_PollAuthSessionStatus_Request) ProtoMessage() {}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_PollAuthSessionStatus_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_PollAuthSessionStatus_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) GetRequestId() []byte {
+	if x != nil {
+		return x.RequestId
+	}
+	return nil
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Request) GetTokenToRevoke() uint64 {
+	if x != nil && x.TokenToRevoke != nil {
+		return *x.TokenToRevoke
+	}
+	return 0
+}
+
+type CAuthentication_PollAuthSessionStatus_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewClientId          *uint64 `protobuf:"varint,1,opt,name=new_client_id,json=newClientId" json:"new_client_id,omitempty"`
+	NewChallengeUrl      *string `protobuf:"bytes,2,opt,name=new_challenge_url,json=newChallengeUrl" json:"new_challenge_url,omitempty"`
+	RefreshToken         *string `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+	AccessToken          *string `protobuf:"bytes,4,opt,name=access_token,json=accessToken" json:"access_token,omitempty"`
+	HadRemoteInteraction *bool   `protobuf:"varint,5,opt,name=had_remote_interaction,json=hadRemoteInteraction" json:"had_remote_interaction,omitempty"`
+	AccountName          *string `protobuf:"bytes,6,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	NewGuardData         *string `protobuf:"bytes,7,opt,name=new_guard_data,json=newGuardData" json:"new_guard_data,omitempty"`
+	AgreementSessionUrl  *string `protobuf:"bytes,8,opt,name=agreement_session_url,json=agreementSessionUrl" json:"agreement_session_url,omitempty"`
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) Reset() {
+	*x = CAuthentication_PollAuthSessionStatus_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_PollAuthSessionStatus_Response) ProtoMessage() {}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_PollAuthSessionStatus_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_PollAuthSessionStatus_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetNewClientId() uint64 {
+	if x != nil && x.NewClientId != nil {
+		return *x.NewClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetNewChallengeUrl() string {
+	if x != nil && x.NewChallengeUrl != nil {
+		return *x.NewChallengeUrl
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetAccessToken() string {
+	if x != nil && x.AccessToken != nil {
+		return *x.AccessToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetHadRemoteInteraction() bool {
+	if x != nil && x.HadRemoteInteraction != nil {
+		return *x.HadRemoteInteraction
+	}
+	return false
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetNewGuardData() string {
+	if x != nil && x.NewGuardData != nil {
+		return *x.NewGuardData
+	}
+	return ""
+}
+
+func (x *CAuthentication_PollAuthSessionStatus_Response) GetAgreementSessionUrl() string {
+	if x != nil && x.AgreementSessionUrl != nil {
+		return *x.AgreementSessionUrl
+	}
+	return ""
+}
+
+type CAuthentication_GetAuthSessionInfo_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId *uint64 `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Request) Reset() {
+	*x = CAuthentication_GetAuthSessionInfo_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetAuthSessionInfo_Request) ProtoMessage() {}
+
+func (x *CAuthentication_GetAuthSessionInfo_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetAuthSessionInfo_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetAuthSessionInfo_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Request) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+type CAuthentication_GetAuthSessionInfo_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip                        *string                      `protobuf:"bytes,1,opt,name=ip" json:"ip,omitempty"`
+	Geoloc                    *string                      `protobuf:"bytes,2,opt,name=geoloc" json:"geoloc,omitempty"`
+	City                      *string                      `protobuf:"bytes,3,opt,name=city" json:"city,omitempty"`
+	State                     *string                      `protobuf:"bytes,4,opt,name=state" json:"state,omitempty"`
+	Country                   *string                      `protobuf:"bytes,5,opt,name=country" json:"country,omitempty"`
+	PlatformType              *EAuthTokenPlatformType      `protobuf:"varint,6,opt,name=platform_type,json=platformType,enum=EAuthTokenPlatformType,def=0" json:"platform_type,omitempty"`
+	DeviceFriendlyName        *string                      `protobuf:"bytes,7,opt,name=device_friendly_name,json=deviceFriendlyName" json:"device_friendly_name,omitempty"`
+	Version                   *int32                       `protobuf:"varint,8,opt,name=version" json:"version,omitempty"`
+	LoginHistory              *EAuthSessionSecurityHistory `protobuf:"varint,9,opt,name=login_history,json=loginHistory,enum=EAuthSessionSecurityHistory,def=0" json:"login_history,omitempty"`
+	RequestorLocationMismatch *bool                        `protobuf:"varint,10,opt,name=requestor_location_mismatch,json=requestorLocationMismatch" json:"requestor_location_mismatch,omitempty"`
+	HighUsageLogin            *bool                        `protobuf:"varint,11,opt,name=high_usage_login,json=highUsageLogin" json:"high_usage_login,omitempty"`
+	RequestedPersistence      *ESessionPersistence         `protobuf:"varint,12,opt,name=requested_persistence,json=requestedPersistence,enum=ESessionPersistence,def=0" json:"requested_persistence,omitempty"`
+}
+
+// Default values for CAuthentication_GetAuthSessionInfo_Response fields.
+const (
+	Default_CAuthentication_GetAuthSessionInfo_Response_PlatformType         = EAuthTokenPlatformType_k_EAuthTokenPlatformType_Unknown
+	Default_CAuthentication_GetAuthSessionInfo_Response_LoginHistory         = EAuthSessionSecurityHistory_k_EAuthSessionSecurityHistory_Invalid
+	Default_CAuthentication_GetAuthSessionInfo_Response_RequestedPersistence = ESessionPersistence_k_ESessionPersistence_Ephemeral
+)
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) Reset() {
+	*x = CAuthentication_GetAuthSessionInfo_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_GetAuthSessionInfo_Response) ProtoMessage() {}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_GetAuthSessionInfo_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_GetAuthSessionInfo_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetGeoloc() string {
+	if x != nil && x.Geoloc != nil {
+		return *x.Geoloc
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetPlatformType() EAuthTokenPlatformType {
+	if x != nil && x.PlatformType != nil {
+		return *x.PlatformType
+	}
+	return Default_CAuthentication_GetAuthSessionInfo_Response_PlatformType
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetDeviceFriendlyName() string {
+	if x != nil && x.DeviceFriendlyName != nil {
+		return *x.DeviceFriendlyName
+	}
+	return ""
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetLoginHistory() EAuthSessionSecurityHistory {
+	if x != nil && x.LoginHistory != nil {
+		return *x.LoginHistory
+	}
+	return Default_CAuthentication_GetAuthSessionInfo_Response_LoginHistory
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetRequestorLocationMismatch() bool {
+	if x != nil && x.RequestorLocationMismatch != nil {
+		return *x.RequestorLocationMismatch
+	}
+	return false
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetHighUsageLogin() bool {
+	if x != nil && x.HighUsageLogin != nil {
+		return *x.HighUsageLogin
+	}
+	return false
+}
+
+func (x *CAuthentication_GetAuthSessionInfo_Response) GetRequestedPersistence() ESessionPersistence {
+	if x != nil && x.RequestedPersistence != nil {
+		return *x.RequestedPersistence
+	}
+	return Default_CAuthentication_GetAuthSessionInfo_Response_RequestedPersistence
+}
+
+type CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version     *int32               `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	ClientId    *uint64              `protobuf:"varint,2,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	Steamid     *uint64              `protobuf:"fixed64,3,opt,name=steamid" json:"steamid,omitempty"`
+	Signature   []byte               `protobuf:"bytes,4,opt,name=signature" json:"signature,omitempty"`
+	Confirm     *bool                `protobuf:"varint,5,opt,name=confirm,def=0" json:"confirm,omitempty"`
+	Persistence *ESessionPersistence `protobuf:"varint,6,opt,name=persistence,enum=ESessionPersistence,def=1" json:"persistence,omitempty"`
+}
+
+// Default values for CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request fields.
+const (
+	Default_CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request_Confirm     = bool(false)
+	Default_CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request_Persistence = ESessionPersistence_k_ESessionPersistence_Persistent
+)
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) Reset() {
+	*x = CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) ProtoMessage() {}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetConfirm() bool {
+	if x != nil && x.Confirm != nil {
+		return *x.Confirm
+	}
+	return Default_CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request_Confirm
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request) GetPersistence() ESessionPersistence {
+	if x != nil && x.Persistence != nil {
+		return *x.Persistence
+	}
+	return Default_CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request_Persistence
+}
+
+type CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) Reset() {
+	*x = CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) ProtoMessage() {}
+
+func (x *CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{13}
+}
+
+type CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId *uint64                `protobuf:"varint,1,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	Steamid  *uint64                `protobuf:"fixed64,2,opt,name=steamid" json:"steamid,omitempty"`
+	Code     *string                `protobuf:"bytes,3,opt,name=code" json:"code,omitempty"`
+	CodeType *EAuthSessionGuardType `protobuf:"varint,4,opt,name=code_type,json=codeType,enum=EAuthSessionGuardType,def=0" json:"code_type,omitempty"`
+}
+
+// Default values for CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request fields.
+const (
+	Default_CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request_CodeType = EAuthSessionGuardType_k_EAuthSessionGuardType_Unknown
+)
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) Reset() {
+	*x = CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) ProtoMessage() {}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) GetClientId() uint64 {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request) GetCodeType() EAuthSessionGuardType {
+	if x != nil && x.CodeType != nil {
+		return *x.CodeType
+	}
+	return Default_CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request_CodeType
+}
+
+type CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgreementSessionUrl *string `protobuf:"bytes,7,opt,name=agreement_session_url,json=agreementSessionUrl" json:"agreement_session_url,omitempty"`
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) Reset() {
+	*x = CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) ProtoMessage() {}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response) GetAgreementSessionUrl() string {
+	if x != nil && x.AgreementSessionUrl != nil {
+		return *x.AgreementSessionUrl
+	}
+	return ""
+}
+
+type CAuthentication_AccessToken_GenerateForApp_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken *string            `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+	Steamid      *uint64            `protobuf:"fixed64,2,opt,name=steamid" json:"steamid,omitempty"`
+	RenewalType  *ETokenRenewalType `protobuf:"varint,3,opt,name=renewal_type,json=renewalType,enum=ETokenRenewalType,def=0" json:"renewal_type,omitempty"`
+}
+
+// Default values for CAuthentication_AccessToken_GenerateForApp_Request fields.
+const (
+	Default_CAuthentication_AccessToken_GenerateForApp_Request_RenewalType = ETokenRenewalType_k_ETokenRenewalType_None
+)
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) Reset() {
+	*x = CAuthentication_AccessToken_GenerateForApp_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_AccessToken_GenerateForApp_Request) ProtoMessage() {}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_AccessToken_GenerateForApp_Request.ProtoReflect.Descriptor instead.
+func (*CAuthentication_AccessToken_GenerateForApp_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Request) GetRenewalType() ETokenRenewalType {
+	if x != nil && x.RenewalType != nil {
+		return *x.RenewalType
+	}
+	return Default_CAuthentication_AccessToken_GenerateForApp_Request_RenewalType
+}
+
+type CAuthentication_AccessToken_GenerateForApp_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  *string `protobuf:"bytes,1,opt,name=access_token,json=accessToken" json:"access_token,omitempty"`
+	RefreshToken *string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) Reset() {
+	*x = CAuthentication_AccessToken_GenerateForApp_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_auth_steamclient_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAuthentication_AccessToken_GenerateForApp_Response) ProtoMessage() {}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_auth_steamclient_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAuthentication_AccessToken_GenerateForApp_Response.ProtoReflect.Descriptor instead.
+func (*CAuthentication_AccessToken_GenerateForApp_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_auth_steamclient_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) GetAccessToken() string {
+	if x != nil && x.AccessToken != nil {
+		return *x.AccessToken
+	}
+	return ""
+}
+
+func (x *CAuthentication_AccessToken_GenerateForApp_Response) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+var File_steammessages_auth_steamclient_proto protoreflect.FileDescriptor
+
+var file_steammessages_auth_steamclient_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x54, 0x0a, 0x2f, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9a, 0x01, 0x0a,
+	0x30, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x53, 0x41, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x79, 0x5f, 0x6d,
+	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x6b, 0x65, 0x79, 0x4d, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x6b, 0x65, 0x79, 0x5f, 0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xf8, 0x01, 0x0a, 0x1d, 0x43, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5e, 0x0a,
+	0x0d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x45, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x20, 0x6b,
+	0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52,
+	0x0c, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6f, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6f, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x67, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x2d, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x61, 0x51, 0x52, 0x5f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x45, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x20, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x5f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x26, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x3a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x09, 0x77, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x23, 0x43, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x64, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x45, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x3a, 0x1f, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x61, 0x51, 0x52, 0x5f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x59, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x05, 0x0a, 0x36, 0x43, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x69,
+	0x61, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x5e, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x45, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x3a, 0x20, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x45, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x20, 0x6b,
+	0x5f, 0x45, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0a,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x3a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x69,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x43,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0d, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x75, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x09, 0x71, 0x6f, 0x73, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x32, 0x52, 0x08, 0x71, 0x6f,
+	0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x8f, 0x03, 0x0a, 0x37, 0x43, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x61, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x59, 0x0a, 0x15, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x43, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x61, 0x6b, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65, 0x61, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32,
+	0x0a, 0x15, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55,
+	0x72, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x2d, 0x43, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x50, 0x6f, 0x6c,
+	0x6c, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x52,
+	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x22, 0xfb,
+	0x02, 0x0a, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x68, 0x61, 0x64,
+	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x68, 0x61, 0x64, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x47,
+	0x75, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x22, 0x49, 0x0a, 0x2a,
+	0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x85, 0x05, 0x0a, 0x2b, 0x43, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x5f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x6f,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x6f, 0x63, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x5e, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x45, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x3a, 0x20, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x68, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x3a, 0x25, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x0c, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x1b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x69, 0x67,
+	0x68, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x6a, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x45, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x1f, 0x6b, 0x5f, 0x45, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0xab, 0x02, 0x0a, 0x3f, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x73, 0x74, 0x65,
+	0x61, 0x6d, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x12, 0x58, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x45, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x20,
+	0x6b, 0x5f, 0x45, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x42, 0x0a,
+	0x40, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xde, 0x01, 0x0a, 0x3b, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x65, 0x61, 0x6d,
+	0x47, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52,
+	0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x09,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x1f, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x5f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x72, 0x0a, 0x3c, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x65, 0x61, 0x6d,
+	0x47, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x22, 0xc4, 0x01, 0x0a, 0x32, 0x43, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46,
+	0x6f, 0x72, 0x41, 0x70, 0x70, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x06, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0c,
+	0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x18, 0x6b, 0x5f, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65,
+	0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7d, 0x0a,
+	0x33, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x5f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0xb9, 0x01, 0x0a,
+	0x16, 0x45, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x6b, 0x5f, 0x45, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x5f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x28, 0x0a,
+	0x24, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x74, 0x65, 0x61, 0x6d, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x6b, 0x5f, 0x45, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x5f, 0x57, 0x65, 0x62, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x72, 0x10, 0x02,
+	0x12, 0x26, 0x0a, 0x22, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4d, 0x6f, 0x62,
+	0x69, 0x6c, 0x65, 0x41, 0x70, 0x70, 0x10, 0x03, 0x2a, 0xe5, 0x02, 0x0a, 0x15, 0x45, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x6b, 0x5f, 0x45, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x6b, 0x5f, 0x45,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x5f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x02,
+	0x12, 0x26, 0x0a, 0x22, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x03, 0x12, 0x2e, 0x0a, 0x2a, 0x6b, 0x5f, 0x45, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x5f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x2d, 0x0a, 0x29, 0x6b, 0x5f, 0x45, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x5f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12, 0x28, 0x0a, 0x24, 0x6b, 0x5f, 0x45, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x5f, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x10,
+	0x06, 0x12, 0x2d, 0x0a, 0x29, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x41, 0x75, 0x74, 0x68, 0x10, 0x07,
+	0x2a, 0xac, 0x01, 0x0a, 0x1b, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x29, 0x0a, 0x25, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x00, 0x12, 0x30, 0x0a, 0x2c, 0x6b,
+	0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x55, 0x73, 0x65,
+	0x64, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x30, 0x0a,
+	0x2c, 0x6b, 0x5f, 0x45, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x4e,
+	0x6f, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x02, 0x2a,
+	0x8c, 0x01, 0x0a, 0x13, 0x45, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x1d, 0x6b, 0x5f, 0x45, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x6b, 0x5f, 0x45, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x45, 0x70, 0x68,
+	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x6b, 0x5f, 0x45, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x10, 0x01, 0x2a, 0x50,
+	0x0a, 0x11, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x6b, 0x5f, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x6b, 0x5f, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x10, 0x01,
+	0x32, 0xea, 0x08, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x30,
+	0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x53, 0x41, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x53,
+	0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x15, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x61, 0x51, 0x52, 0x12, 0x2e, 0x2e, 0x43,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x69, 0x61, 0x51, 0x52, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x43,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x69, 0x61, 0x51, 0x52, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01,
+	0x0a, 0x1e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x56, 0x69, 0x61, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x37, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x69, 0x61, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x43, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x61, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x15, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x2e, 0x43,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x50,
+	0x6f, 0x6c, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x43,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x50,
+	0x6f, 0x6c, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xae,
+	0x01, 0x0a, 0x27, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x2e, 0x43, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x43,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0xa2, 0x01, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x65, 0x61, 0x6d, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x74, 0x65, 0x61, 0x6d, 0x47, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x5f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x65,
+	0x61, 0x6d, 0x47, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x41,
+	0x70, 0x70, 0x12, 0x33, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x5f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x43, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6f,
+	0x72, 0x41, 0x70, 0x70, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x03, 0x80,
+	0x01, 0x01,
+}
+
+var (
+	file_steammessages_auth_steamclient_proto_rawDescOnce sync.Once
+	file_steammessages_auth_steamclient_proto_rawDescData = file_steammessages_auth_steamclient_proto_rawDesc
+)
+
+func file_steammessages_auth_steamclient_proto_rawDescGZIP() []byte {
+	file_steammessages_auth_steamclient_proto_rawDescOnce.Do(func() {
+		file_steammessages_auth_steamclient_proto_rawDescData = protoimpl.X.CompressGZIP(file_steammessages_auth_steamclient_proto_rawDescData)
+	})
+	return file_steammessages_auth_steamclient_proto_rawDescData
+}
+
+var file_steammessages_auth_steamclient_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_steammessages_auth_steamclient_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_steammessages_auth_steamclient_proto_goTypes = []interface{}{
+	(EAuthTokenPlatformType)(0),                                              // 0: EAuthTokenPlatformType
+	(EAuthSessionGuardType)(0),                                               // 1: EAuthSessionGuardType
+	(EAuthSessionSecurityHistory)(0),                                         // 2: EAuthSessionSecurityHistory
+	(ESessionPersistence)(0),                                                 // 3: ESessionPersistence
+	(ETokenRenewalType)(0),                                                   // 4: ETokenRenewalType
+	(*CAuthentication_GetPasswordRSAPublicKey_Request)(nil),                  // 5: CAuthentication_GetPasswordRSAPublicKey_Request
+	(*CAuthentication_GetPasswordRSAPublicKey_Response)(nil),                 // 6: CAuthentication_GetPasswordRSAPublicKey_Response
+	(*CAuthentication_DeviceDetails)(nil),                                    // 7: CAuthentication_DeviceDetails
+	(*CAuthentication_BeginAuthSessionViaQR_Request)(nil),                    // 8: CAuthentication_BeginAuthSessionViaQR_Request
+	(*CAuthentication_AllowedConfirmation)(nil),                              // 9: CAuthentication_AllowedConfirmation
+	(*CAuthentication_BeginAuthSessionViaQR_Response)(nil),                   // 10: CAuthentication_BeginAuthSessionViaQR_Response
+	(*CAuthentication_BeginAuthSessionViaCredentials_Request)(nil),           // 11: CAuthentication_BeginAuthSessionViaCredentials_Request
+	(*CAuthentication_BeginAuthSessionViaCredentials_Response)(nil),          // 12: CAuthentication_BeginAuthSessionViaCredentials_Response
+	(*CAuthentication_PollAuthSessionStatus_Request)(nil),                    // 13: CAuthentication_PollAuthSessionStatus_Request
+	(*CAuthentication_PollAuthSessionStatus_Response)(nil),                   // 14: CAuthentication_PollAuthSessionStatus_Response
+	(*CAuthentication_GetAuthSessionInfo_Request)(nil),                       // 15: CAuthentication_GetAuthSessionInfo_Request
+	(*CAuthentication_GetAuthSessionInfo_Response)(nil),                      // 16: CAuthentication_GetAuthSessionInfo_Response
+	(*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request)(nil),  // 17: CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request
+	(*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response)(nil), // 18: CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response
+	(*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request)(nil),      // 19: CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request
+	(*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response)(nil),     // 20: CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response
+	(*CAuthentication_AccessToken_GenerateForApp_Request)(nil),               // 21: CAuthentication_AccessToken_GenerateForApp_Request
+	(*CAuthentication_AccessToken_GenerateForApp_Response)(nil),              // 22: CAuthentication_AccessToken_GenerateForApp_Response
+}
+var file_steammessages_auth_steamclient_proto_depIdxs = []int32{
+	0,  // 0: CAuthentication_DeviceDetails.platform_type:type_name -> EAuthTokenPlatformType
+	0,  // 1: CAuthentication_BeginAuthSessionViaQR_Request.platform_type:type_name -> EAuthTokenPlatformType
+	7,  // 2: CAuthentication_BeginAuthSessionViaQR_Request.device_details:type_name -> CAuthentication_DeviceDetails
+	1,  // 3: CAuthentication_AllowedConfirmation.confirmation_type:type_name -> EAuthSessionGuardType
+	9,  // 4: CAuthentication_BeginAuthSessionViaQR_Response.allowed_confirmations:type_name -> CAuthentication_AllowedConfirmation
+	0,  // 5: CAuthentication_BeginAuthSessionViaCredentials_Request.platform_type:type_name -> EAuthTokenPlatformType
+	3,  // 6: CAuthentication_BeginAuthSessionViaCredentials_Request.persistence:type_name -> ESessionPersistence
+	7,  // 7: CAuthentication_BeginAuthSessionViaCredentials_Request.device_details:type_name -> CAuthentication_DeviceDetails
+	9,  // 8: CAuthentication_BeginAuthSessionViaCredentials_Response.allowed_confirmations:type_name -> CAuthentication_AllowedConfirmation
+	0,  // 9: CAuthentication_GetAuthSessionInfo_Response.platform_type:type_name -> EAuthTokenPlatformType
+	2,  // 10: CAuthentication_GetAuthSessionInfo_Response.login_history:type_name -> EAuthSessionSecurityHistory
+	3,  // 11: CAuthentication_GetAuthSessionInfo_Response.requested_persistence:type_name -> ESessionPersistence
+	3,  // 12: CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request.persistence:type_name -> ESessionPersistence
+	1,  // 13: CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request.code_type:type_name -> EAuthSessionGuardType
+	4,  // 14: CAuthentication_AccessToken_GenerateForApp_Request.renewal_type:type_name -> ETokenRenewalType
+	5,  // 15: Authentication.GetPasswordRSAPublicKey:input_type -> CAuthentication_GetPasswordRSAPublicKey_Request
+	8,  // 16: Authentication.BeginAuthSessionViaQR:input_type -> CAuthentication_BeginAuthSessionViaQR_Request
+	11, // 17: Authentication.BeginAuthSessionViaCredentials:input_type -> CAuthentication_BeginAuthSessionViaCredentials_Request
+	13, // 18: Authentication.PollAuthSessionStatus:input_type -> CAuthentication_PollAuthSessionStatus_Request
+	15, // 19: Authentication.GetAuthSessionInfo:input_type -> CAuthentication_GetAuthSessionInfo_Request
+	17, // 20: Authentication.UpdateAuthSessionWithMobileConfirmation:input_type -> CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request
+	19, // 21: Authentication.UpdateAuthSessionWithSteamGuardCode:input_type -> CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request
+	21, // 22: Authentication.GenerateAccessTokenForApp:input_type -> CAuthentication_AccessToken_GenerateForApp_Request
+	6,  // 23: Authentication.GetPasswordRSAPublicKey:output_type -> CAuthentication_GetPasswordRSAPublicKey_Response
+	10, // 24: Authentication.BeginAuthSessionViaQR:output_type -> CAuthentication_BeginAuthSessionViaQR_Response
+	12, // 25: Authentication.BeginAuthSessionViaCredentials:output_type -> CAuthentication_BeginAuthSessionViaCredentials_Response
+	14, // 26: Authentication.PollAuthSessionStatus:output_type -> CAuthentication_PollAuthSessionStatus_Response
+	16, // 27: Authentication.GetAuthSessionInfo:output_type -> CAuthentication_GetAuthSessionInfo_Response
+	18, // 28: Authentication.UpdateAuthSessionWithMobileConfirmation:output_type -> CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response
+	20, // 29: Authentication.UpdateAuthSessionWithSteamGuardCode:output_type -> CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response
+	22, // 30: Authentication.GenerateAccessTokenForApp:output_type -> CAuthentication_AccessToken_GenerateForApp_Response
+	23, // [23:31] is the sub-list for method output_type
+	15, // [15:23] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_auth_steamclient_proto_init() }
+func file_steammessages_auth_steamclient_proto_init() {
+	if File_steammessages_auth_steamclient_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_steammessages_auth_steamclient_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_GetPasswordRSAPublicKey_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_GetPasswordRSAPublicKey_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_DeviceDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_BeginAuthSessionViaQR_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_AllowedConfirmation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_BeginAuthSessionViaQR_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_BeginAuthSessionViaCredentials_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_BeginAuthSessionViaCredentials_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_PollAuthSessionStatus_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_PollAuthSessionStatus_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_GetAuthSessionInfo_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_GetAuthSessionInfo_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_UpdateAuthSessionWithMobileConfirmation_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_UpdateAuthSessionWithSteamGuardCode_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_AccessToken_GenerateForApp_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_auth_steamclient_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAuthentication_AccessToken_GenerateForApp_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_steammessages_auth_steamclient_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_steammessages_auth_steamclient_proto_goTypes,
+		DependencyIndexes: file_steammessages_auth_steamclient_proto_depIdxs,
+		EnumInfos:         file_steammessages_auth_steamclient_proto_enumTypes,
+		MessageInfos:      file_steammessages_auth_steamclient_proto_msgTypes,
+	}.Build()
+	File_steammessages_auth_steamclient_proto = out.File
+	file_steammessages_auth_steamclient_proto_rawDesc = nil
+	file_steammessages_auth_steamclient_proto_goTypes = nil
+	file_steammessages_auth_steamclient_proto_depIdxs = nil
+}
@@ -0,0 +1,1320 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.1
+// source: steammessages_twofactor.steamclient.proto
+
+package unified
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CTwoFactor_Time_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderTime *uint64 `protobuf:"varint,1,opt,name=sender_time,json=senderTime" json:"sender_time,omitempty"`
+}
+
+func (x *CTwoFactor_Time_Request) Reset() {
+	*x = CTwoFactor_Time_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_Time_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_Time_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_Time_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_Time_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_Time_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CTwoFactor_Time_Request) GetSenderTime() uint64 {
+	if x != nil && x.SenderTime != nil {
+		return *x.SenderTime
+	}
+	return 0
+}
+
+type CTwoFactor_Time_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerTime                        *uint64 `protobuf:"varint,1,opt,name=server_time,json=serverTime" json:"server_time,omitempty"`
+	SkewToleranceSeconds              *uint64 `protobuf:"varint,2,opt,name=skew_tolerance_seconds,json=skewToleranceSeconds" json:"skew_tolerance_seconds,omitempty"`
+	LargeTimeJink                     *uint64 `protobuf:"varint,3,opt,name=large_time_jink,json=largeTimeJink" json:"large_time_jink,omitempty"`
+	ProbeFrequencySeconds             *uint32 `protobuf:"varint,4,opt,name=probe_frequency_seconds,json=probeFrequencySeconds" json:"probe_frequency_seconds,omitempty"`
+	AdjustedTimeProbeFrequencySeconds *uint32 `protobuf:"varint,5,opt,name=adjusted_time_probe_frequency_seconds,json=adjustedTimeProbeFrequencySeconds" json:"adjusted_time_probe_frequency_seconds,omitempty"`
+	HintProbeFrequencySeconds         *uint32 `protobuf:"varint,6,opt,name=hint_probe_frequency_seconds,json=hintProbeFrequencySeconds" json:"hint_probe_frequency_seconds,omitempty"`
+	SyncTimeout                       *uint32 `protobuf:"varint,7,opt,name=sync_timeout,json=syncTimeout" json:"sync_timeout,omitempty"`
+	TryAgainSeconds                   *uint32 `protobuf:"varint,8,opt,name=try_again_seconds,json=tryAgainSeconds" json:"try_again_seconds,omitempty"`
+	MaxAttempts                       *uint32 `protobuf:"varint,9,opt,name=max_attempts,json=maxAttempts" json:"max_attempts,omitempty"`
+}
+
+func (x *CTwoFactor_Time_Response) Reset() {
+	*x = CTwoFactor_Time_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_Time_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_Time_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_Time_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_Time_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_Time_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CTwoFactor_Time_Response) GetServerTime() uint64 {
+	if x != nil && x.ServerTime != nil {
+		return *x.ServerTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetSkewToleranceSeconds() uint64 {
+	if x != nil && x.SkewToleranceSeconds != nil {
+		return *x.SkewToleranceSeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetLargeTimeJink() uint64 {
+	if x != nil && x.LargeTimeJink != nil {
+		return *x.LargeTimeJink
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetProbeFrequencySeconds() uint32 {
+	if x != nil && x.ProbeFrequencySeconds != nil {
+		return *x.ProbeFrequencySeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetAdjustedTimeProbeFrequencySeconds() uint32 {
+	if x != nil && x.AdjustedTimeProbeFrequencySeconds != nil {
+		return *x.AdjustedTimeProbeFrequencySeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetHintProbeFrequencySeconds() uint32 {
+	if x != nil && x.HintProbeFrequencySeconds != nil {
+		return *x.HintProbeFrequencySeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetSyncTimeout() uint32 {
+	if x != nil && x.SyncTimeout != nil {
+		return *x.SyncTimeout
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetTryAgainSeconds() uint32 {
+	if x != nil && x.TryAgainSeconds != nil {
+		return *x.TryAgainSeconds
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Time_Response) GetMaxAttempts() uint32 {
+	if x != nil && x.MaxAttempts != nil {
+		return *x.MaxAttempts
+	}
+	return 0
+}
+
+type CTwoFactor_Status_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steamid *uint64 `protobuf:"fixed64,1,opt,name=steamid" json:"steamid,omitempty"`
+}
+
+func (x *CTwoFactor_Status_Request) Reset() {
+	*x = CTwoFactor_Status_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_Status_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_Status_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_Status_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_Status_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_Status_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CTwoFactor_Status_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+type CTwoFactor_Status_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State                       *uint32 `protobuf:"varint,1,opt,name=state" json:"state,omitempty"`
+	InactivationReason          *uint32 `protobuf:"varint,2,opt,name=inactivation_reason,json=inactivationReason" json:"inactivation_reason,omitempty"`
+	AuthenticatorType           *uint32 `protobuf:"varint,3,opt,name=authenticator_type,json=authenticatorType" json:"authenticator_type,omitempty"`
+	AuthenticatorAllowed        *bool   `protobuf:"varint,4,opt,name=authenticator_allowed,json=authenticatorAllowed" json:"authenticator_allowed,omitempty"`
+	SteamguardScheme            *uint32 `protobuf:"varint,5,opt,name=steamguard_scheme,json=steamguardScheme" json:"steamguard_scheme,omitempty"`
+	TokenGid                    *string `protobuf:"bytes,6,opt,name=token_gid,json=tokenGid" json:"token_gid,omitempty"`
+	EmailValidated              *bool   `protobuf:"varint,7,opt,name=email_validated,json=emailValidated" json:"email_validated,omitempty"`
+	DeviceIdentifier            *string `protobuf:"bytes,8,opt,name=device_identifier,json=deviceIdentifier" json:"device_identifier,omitempty"`
+	TimeCreated                 *uint32 `protobuf:"varint,9,opt,name=time_created,json=timeCreated" json:"time_created,omitempty"`
+	RevocationAttemptsRemaining *uint32 `protobuf:"varint,10,opt,name=revocation_attempts_remaining,json=revocationAttemptsRemaining" json:"revocation_attempts_remaining,omitempty"`
+	ClassifiedAgent             *string `protobuf:"bytes,11,opt,name=classified_agent,json=classifiedAgent" json:"classified_agent,omitempty"`
+	AllowExternalAuthenticator  *bool   `protobuf:"varint,12,opt,name=allow_external_authenticator,json=allowExternalAuthenticator" json:"allow_external_authenticator,omitempty"`
+	TimeTransferred             *uint32 `protobuf:"varint,13,opt,name=time_transferred,json=timeTransferred" json:"time_transferred,omitempty"`
+	Version                     *uint32 `protobuf:"varint,14,opt,name=version" json:"version,omitempty"`
+}
+
+func (x *CTwoFactor_Status_Response) Reset() {
+	*x = CTwoFactor_Status_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_Status_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_Status_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_Status_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_Status_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_Status_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CTwoFactor_Status_Response) GetState() uint32 {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetInactivationReason() uint32 {
+	if x != nil && x.InactivationReason != nil {
+		return *x.InactivationReason
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetAuthenticatorType() uint32 {
+	if x != nil && x.AuthenticatorType != nil {
+		return *x.AuthenticatorType
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetAuthenticatorAllowed() bool {
+	if x != nil && x.AuthenticatorAllowed != nil {
+		return *x.AuthenticatorAllowed
+	}
+	return false
+}
+
+func (x *CTwoFactor_Status_Response) GetSteamguardScheme() uint32 {
+	if x != nil && x.SteamguardScheme != nil {
+		return *x.SteamguardScheme
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetTokenGid() string {
+	if x != nil && x.TokenGid != nil {
+		return *x.TokenGid
+	}
+	return ""
+}
+
+func (x *CTwoFactor_Status_Response) GetEmailValidated() bool {
+	if x != nil && x.EmailValidated != nil {
+		return *x.EmailValidated
+	}
+	return false
+}
+
+func (x *CTwoFactor_Status_Response) GetDeviceIdentifier() string {
+	if x != nil && x.DeviceIdentifier != nil {
+		return *x.DeviceIdentifier
+	}
+	return ""
+}
+
+func (x *CTwoFactor_Status_Response) GetTimeCreated() uint32 {
+	if x != nil && x.TimeCreated != nil {
+		return *x.TimeCreated
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetRevocationAttemptsRemaining() uint32 {
+	if x != nil && x.RevocationAttemptsRemaining != nil {
+		return *x.RevocationAttemptsRemaining
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetClassifiedAgent() string {
+	if x != nil && x.ClassifiedAgent != nil {
+		return *x.ClassifiedAgent
+	}
+	return ""
+}
+
+func (x *CTwoFactor_Status_Response) GetAllowExternalAuthenticator() bool {
+	if x != nil && x.AllowExternalAuthenticator != nil {
+		return *x.AllowExternalAuthenticator
+	}
+	return false
+}
+
+func (x *CTwoFactor_Status_Response) GetTimeTransferred() uint32 {
+	if x != nil && x.TimeTransferred != nil {
+		return *x.TimeTransferred
+	}
+	return 0
+}
+
+func (x *CTwoFactor_Status_Response) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type CTwoFactor_AddAuthenticator_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steamid           *uint64  `protobuf:"fixed64,1,opt,name=steamid" json:"steamid,omitempty"`
+	AuthenticatorTime *uint64  `protobuf:"varint,2,opt,name=authenticator_time,json=authenticatorTime" json:"authenticator_time,omitempty"`
+	SerialNumber      *uint64  `protobuf:"fixed64,3,opt,name=serial_number,json=serialNumber" json:"serial_number,omitempty"`
+	AuthenticatorType *uint32  `protobuf:"varint,4,opt,name=authenticator_type,json=authenticatorType" json:"authenticator_type,omitempty"`
+	DeviceIdentifier  *string  `protobuf:"bytes,5,opt,name=device_identifier,json=deviceIdentifier" json:"device_identifier,omitempty"`
+	SmsPhoneId        *string  `protobuf:"bytes,6,opt,name=sms_phone_id,json=smsPhoneId" json:"sms_phone_id,omitempty"`
+	HttpHeaders       []string `protobuf:"bytes,7,rep,name=http_headers,json=httpHeaders" json:"http_headers,omitempty"`
+	Version           *uint32  `protobuf:"varint,8,opt,name=version,def=1" json:"version,omitempty"`
+}
+
+// Default values for CTwoFactor_AddAuthenticator_Request fields.
+const (
+	Default_CTwoFactor_AddAuthenticator_Request_Version = uint32(1)
+)
+
+func (x *CTwoFactor_AddAuthenticator_Request) Reset() {
+	*x = CTwoFactor_AddAuthenticator_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_AddAuthenticator_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_AddAuthenticator_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_AddAuthenticator_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_AddAuthenticator_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetAuthenticatorTime() uint64 {
+	if x != nil && x.AuthenticatorTime != nil {
+		return *x.AuthenticatorTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetSerialNumber() uint64 {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetAuthenticatorType() uint32 {
+	if x != nil && x.AuthenticatorType != nil {
+		return *x.AuthenticatorType
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetDeviceIdentifier() string {
+	if x != nil && x.DeviceIdentifier != nil {
+		return *x.DeviceIdentifier
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetSmsPhoneId() string {
+	if x != nil && x.SmsPhoneId != nil {
+		return *x.SmsPhoneId
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetHttpHeaders() []string {
+	if x != nil {
+		return x.HttpHeaders
+	}
+	return nil
+}
+
+func (x *CTwoFactor_AddAuthenticator_Request) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return Default_CTwoFactor_AddAuthenticator_Request_Version
+}
+
+type CTwoFactor_AddAuthenticator_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SharedSecret    []byte  `protobuf:"bytes,1,opt,name=shared_secret,json=sharedSecret" json:"shared_secret,omitempty"`
+	SerialNumber    *uint64 `protobuf:"fixed64,2,opt,name=serial_number,json=serialNumber" json:"serial_number,omitempty"`
+	RevocationCode  *string `protobuf:"bytes,3,opt,name=revocation_code,json=revocationCode" json:"revocation_code,omitempty"`
+	Uri             *string `protobuf:"bytes,4,opt,name=uri" json:"uri,omitempty"`
+	ServerTime      *uint64 `protobuf:"varint,5,opt,name=server_time,json=serverTime" json:"server_time,omitempty"`
+	AccountName     *string `protobuf:"bytes,6,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	TokenGid        *string `protobuf:"bytes,7,opt,name=token_gid,json=tokenGid" json:"token_gid,omitempty"`
+	IdentitySecret  []byte  `protobuf:"bytes,8,opt,name=identity_secret,json=identitySecret" json:"identity_secret,omitempty"`
+	Secret_1        []byte  `protobuf:"bytes,9,opt,name=secret_1,json=secret1" json:"secret_1,omitempty"`
+	Status          *int32  `protobuf:"varint,10,opt,name=status" json:"status,omitempty"`
+	PhoneNumberHint *string `protobuf:"bytes,11,opt,name=phone_number_hint,json=phoneNumberHint" json:"phone_number_hint,omitempty"`
+	ConfirmType     *int32  `protobuf:"varint,12,opt,name=confirm_type,json=confirmType" json:"confirm_type,omitempty"`
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) Reset() {
+	*x = CTwoFactor_AddAuthenticator_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_AddAuthenticator_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_AddAuthenticator_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_AddAuthenticator_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_AddAuthenticator_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetSharedSecret() []byte {
+	if x != nil {
+		return x.SharedSecret
+	}
+	return nil
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetSerialNumber() uint64 {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetRevocationCode() string {
+	if x != nil && x.RevocationCode != nil {
+		return *x.RevocationCode
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetUri() string {
+	if x != nil && x.Uri != nil {
+		return *x.Uri
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetServerTime() uint64 {
+	if x != nil && x.ServerTime != nil {
+		return *x.ServerTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetAccountName() string {
+	if x != nil && x.AccountName != nil {
+		return *x.AccountName
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetTokenGid() string {
+	if x != nil && x.TokenGid != nil {
+		return *x.TokenGid
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetIdentitySecret() []byte {
+	if x != nil {
+		return x.IdentitySecret
+	}
+	return nil
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetSecret_1() []byte {
+	if x != nil {
+		return x.Secret_1
+	}
+	return nil
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetPhoneNumberHint() string {
+	if x != nil && x.PhoneNumberHint != nil {
+		return *x.PhoneNumberHint
+	}
+	return ""
+}
+
+func (x *CTwoFactor_AddAuthenticator_Response) GetConfirmType() int32 {
+	if x != nil && x.ConfirmType != nil {
+		return *x.ConfirmType
+	}
+	return 0
+}
+
+type CTwoFactor_FinalizeAddAuthenticator_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steamid           *uint64  `protobuf:"fixed64,1,opt,name=steamid" json:"steamid,omitempty"`
+	AuthenticatorCode *string  `protobuf:"bytes,2,opt,name=authenticator_code,json=authenticatorCode" json:"authenticator_code,omitempty"`
+	AuthenticatorTime *uint64  `protobuf:"varint,3,opt,name=authenticator_time,json=authenticatorTime" json:"authenticator_time,omitempty"`
+	ActivationCode    *string  `protobuf:"bytes,4,opt,name=activation_code,json=activationCode" json:"activation_code,omitempty"`
+	HttpHeaders       []string `protobuf:"bytes,5,rep,name=http_headers,json=httpHeaders" json:"http_headers,omitempty"`
+	ValidateSmsCode   *bool    `protobuf:"varint,6,opt,name=validate_sms_code,json=validateSmsCode" json:"validate_sms_code,omitempty"`
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) Reset() {
+	*x = CTwoFactor_FinalizeAddAuthenticator_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_FinalizeAddAuthenticator_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_FinalizeAddAuthenticator_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_FinalizeAddAuthenticator_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetSteamid() uint64 {
+	if x != nil && x.Steamid != nil {
+		return *x.Steamid
+	}
+	return 0
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetAuthenticatorCode() string {
+	if x != nil && x.AuthenticatorCode != nil {
+		return *x.AuthenticatorCode
+	}
+	return ""
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetAuthenticatorTime() uint64 {
+	if x != nil && x.AuthenticatorTime != nil {
+		return *x.AuthenticatorTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetActivationCode() string {
+	if x != nil && x.ActivationCode != nil {
+		return *x.ActivationCode
+	}
+	return ""
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetHttpHeaders() []string {
+	if x != nil {
+		return x.HttpHeaders
+	}
+	return nil
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Request) GetValidateSmsCode() bool {
+	if x != nil && x.ValidateSmsCode != nil {
+		return *x.ValidateSmsCode
+	}
+	return false
+}
+
+type CTwoFactor_FinalizeAddAuthenticator_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    *bool   `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	WantMore   *bool   `protobuf:"varint,2,opt,name=want_more,json=wantMore" json:"want_more,omitempty"`
+	ServerTime *uint64 `protobuf:"varint,3,opt,name=server_time,json=serverTime" json:"server_time,omitempty"`
+	Status     *int32  `protobuf:"varint,4,opt,name=status" json:"status,omitempty"`
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) Reset() {
+	*x = CTwoFactor_FinalizeAddAuthenticator_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_FinalizeAddAuthenticator_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_FinalizeAddAuthenticator_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_FinalizeAddAuthenticator_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) GetWantMore() bool {
+	if x != nil && x.WantMore != nil {
+		return *x.WantMore
+	}
+	return false
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) GetServerTime() uint64 {
+	if x != nil && x.ServerTime != nil {
+		return *x.ServerTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_FinalizeAddAuthenticator_Response) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+type CTwoFactor_RemoveAuthenticator_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevocationCode             *string `protobuf:"bytes,2,opt,name=revocation_code,json=revocationCode" json:"revocation_code,omitempty"`
+	RevocationReason           *uint32 `protobuf:"varint,5,opt,name=revocation_reason,json=revocationReason" json:"revocation_reason,omitempty"`
+	SteamguardScheme           *uint32 `protobuf:"varint,6,opt,name=steamguard_scheme,json=steamguardScheme" json:"steamguard_scheme,omitempty"`
+	RemoveAllSteamguardCookies *bool   `protobuf:"varint,7,opt,name=remove_all_steamguard_cookies,json=removeAllSteamguardCookies" json:"remove_all_steamguard_cookies,omitempty"`
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) Reset() {
+	*x = CTwoFactor_RemoveAuthenticator_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_RemoveAuthenticator_Request) ProtoMessage() {}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_RemoveAuthenticator_Request.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_RemoveAuthenticator_Request) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) GetRevocationCode() string {
+	if x != nil && x.RevocationCode != nil {
+		return *x.RevocationCode
+	}
+	return ""
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) GetRevocationReason() uint32 {
+	if x != nil && x.RevocationReason != nil {
+		return *x.RevocationReason
+	}
+	return 0
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) GetSteamguardScheme() uint32 {
+	if x != nil && x.SteamguardScheme != nil {
+		return *x.SteamguardScheme
+	}
+	return 0
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Request) GetRemoveAllSteamguardCookies() bool {
+	if x != nil && x.RemoveAllSteamguardCookies != nil {
+		return *x.RemoveAllSteamguardCookies
+	}
+	return false
+}
+
+type CTwoFactor_RemoveAuthenticator_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success                     *bool   `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	ServerTime                  *uint64 `protobuf:"varint,3,opt,name=server_time,json=serverTime" json:"server_time,omitempty"`
+	RevocationAttemptsRemaining *uint32 `protobuf:"varint,5,opt,name=revocation_attempts_remaining,json=revocationAttemptsRemaining" json:"revocation_attempts_remaining,omitempty"`
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) Reset() {
+	*x = CTwoFactor_RemoveAuthenticator_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CTwoFactor_RemoveAuthenticator_Response) ProtoMessage() {}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_steammessages_twofactor_steamclient_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CTwoFactor_RemoveAuthenticator_Response.ProtoReflect.Descriptor instead.
+func (*CTwoFactor_RemoveAuthenticator_Response) Descriptor() ([]byte, []int) {
+	return file_steammessages_twofactor_steamclient_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) GetServerTime() uint64 {
+	if x != nil && x.ServerTime != nil {
+		return *x.ServerTime
+	}
+	return 0
+}
+
+func (x *CTwoFactor_RemoveAuthenticator_Response) GetRevocationAttemptsRemaining() uint32 {
+	if x != nil && x.RevocationAttemptsRemaining != nil {
+		return *x.RevocationAttemptsRemaining
+	}
+	return 0
+}
+
+var File_steammessages_twofactor_steamclient_proto protoreflect.FileDescriptor
+
+var file_steammessages_twofactor_steamclient_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x74, 0x77, 0x6f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3a, 0x0a, 0x17, 0x43,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x54, 0x69, 0x6d, 0x65, 0x5f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd6, 0x03, 0x0a, 0x18, 0x43, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x54, 0x69, 0x6d, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x6b, 0x65, 0x77, 0x5f, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x73, 0x6b, 0x65, 0x77, 0x54, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x61, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x69, 0x6e, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4a,
+	0x69, 0x6e, 0x6b, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x46, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x50, 0x0a, 0x25, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x21, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3f, 0x0a,
+	0x1c, 0x68, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x19, 0x68, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x72,
+	0x79, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x22, 0x35, 0x0a, 0x19, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07,
+	0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x22, 0x80, 0x05, 0x0a, 0x1a, 0x43, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x13,
+	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x69, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
+	0x12, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x15,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x67, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x47, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x1d, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x72, 0x65, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x1c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd1, 0x02, 0x0a, 0x23, 0x43,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x41, 0x64, 0x64, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x06, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x06, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x2d, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c,
+	0x73, 0x6d, 0x73, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6d, 0x73, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x1b, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x3a, 0x01, 0x31, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb7,
+	0x03, 0x0a, 0x24, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x41, 0x64,
+	0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x06, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x67, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x47, 0x69, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x31, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x31, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9d, 0x02, 0x0a, 0x2b, 0x43, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x41, 0x64, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x61,
+	0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x07, 0x73, 0x74, 0x65, 0x61, 0x6d,
+	0x69, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x74, 0x74,
+	0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x68, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x6d, 0x73, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x6d, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x2c, 0x43, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x41, 0x64, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x61, 0x6e, 0x74, 0x4d, 0x6f, 0x72, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x26, 0x43, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72,
+	0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74,
+	0x65, 0x61, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x27, 0x43,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x42, 0x0a, 0x1d, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x32, 0xd9, 0x03, 0x0a, 0x09, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x41,
+	0x64, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x24, 0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x41, 0x64, 0x64,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x41, 0x64, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x43, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x54, 0x69, 0x6d, 0x65, 0x5f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x54, 0x69, 0x6d, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x43, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x03, 0x80, 0x01, 0x01,
+}
+
+var (
+	file_steammessages_twofactor_steamclient_proto_rawDescOnce sync.Once
+	file_steammessages_twofactor_steamclient_proto_rawDescData = file_steammessages_twofactor_steamclient_proto_rawDesc
+)
+
+func file_steammessages_twofactor_steamclient_proto_rawDescGZIP() []byte {
+	file_steammessages_twofactor_steamclient_proto_rawDescOnce.Do(func() {
+		file_steammessages_twofactor_steamclient_proto_rawDescData = protoimpl.X.CompressGZIP(file_steammessages_twofactor_steamclient_proto_rawDescData)
+	})
+	return file_steammessages_twofactor_steamclient_proto_rawDescData
+}
+
+var file_steammessages_twofactor_steamclient_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_steammessages_twofactor_steamclient_proto_goTypes = []interface{}{
+	(*CTwoFactor_Time_Request)(nil),                      // 0: CTwoFactor_Time_Request
+	(*CTwoFactor_Time_Response)(nil),                     // 1: CTwoFactor_Time_Response
+	(*CTwoFactor_Status_Request)(nil),                    // 2: CTwoFactor_Status_Request
+	(*CTwoFactor_Status_Response)(nil),                   // 3: CTwoFactor_Status_Response
+	(*CTwoFactor_AddAuthenticator_Request)(nil),          // 4: CTwoFactor_AddAuthenticator_Request
+	(*CTwoFactor_AddAuthenticator_Response)(nil),         // 5: CTwoFactor_AddAuthenticator_Response
+	(*CTwoFactor_FinalizeAddAuthenticator_Request)(nil),  // 6: CTwoFactor_FinalizeAddAuthenticator_Request
+	(*CTwoFactor_FinalizeAddAuthenticator_Response)(nil), // 7: CTwoFactor_FinalizeAddAuthenticator_Response
+	(*CTwoFactor_RemoveAuthenticator_Request)(nil),       // 8: CTwoFactor_RemoveAuthenticator_Request
+	(*CTwoFactor_RemoveAuthenticator_Response)(nil),      // 9: CTwoFactor_RemoveAuthenticator_Response
+}
+var file_steammessages_twofactor_steamclient_proto_depIdxs = []int32{
+	2, // 0: TwoFactor.QueryStatus:input_type -> CTwoFactor_Status_Request
+	4, // 1: TwoFactor.AddAuthenticator:input_type -> CTwoFactor_AddAuthenticator_Request
+	0, // 2: TwoFactor.QueryTime:input_type -> CTwoFactor_Time_Request
+	8, // 3: TwoFactor.RemoveAuthenticator:input_type -> CTwoFactor_RemoveAuthenticator_Request
+	6, // 4: TwoFactor.FinalizeAddAuthenticator:input_type -> CTwoFactor_FinalizeAddAuthenticator_Request
+	3, // 5: TwoFactor.QueryStatus:output_type -> CTwoFactor_Status_Response
+	5, // 6: TwoFactor.AddAuthenticator:output_type -> CTwoFactor_AddAuthenticator_Response
+	1, // 7: TwoFactor.QueryTime:output_type -> CTwoFactor_Time_Response
+	9, // 8: TwoFactor.RemoveAuthenticator:output_type -> CTwoFactor_RemoveAuthenticator_Response
+	7, // 9: TwoFactor.FinalizeAddAuthenticator:output_type -> CTwoFactor_FinalizeAddAuthenticator_Response
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_steammessages_twofactor_steamclient_proto_init() }
+func file_steammessages_twofactor_steamclient_proto_init() {
+	if File_steammessages_twofactor_steamclient_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_steammessages_twofactor_steamclient_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_Time_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_Time_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_Status_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_Status_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_AddAuthenticator_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_AddAuthenticator_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_FinalizeAddAuthenticator_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_FinalizeAddAuthenticator_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_RemoveAuthenticator_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_steammessages_twofactor_steamclient_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CTwoFactor_RemoveAuthenticator_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_steammessages_twofactor_steamclient_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_steammessages_twofactor_steamclient_proto_goTypes,
+		DependencyIndexes: file_steammessages_twofactor_steamclient_proto_depIdxs,
+		MessageInfos:      file_steammessages_twofactor_steamclient_proto_msgTypes,
+	}.Build()
+	File_steammessages_twofactor_steamclient_proto = out.File
+	file_steammessages_twofactor_steamclient_proto_rawDesc = nil
+	file_steammessages_twofactor_steamclient_proto_goTypes = nil
+	file_steammessages_twofactor_steamclient_proto_depIdxs = nil
+}
@@ -1608,6 +1608,7 @@ const (
 	EMsg_ClientVoiceCallPreAuthorizeResponse                      EMsg = 9801
 	EMsg_ClientServerTimestampRequest                             EMsg = 9802
 	EMsg_ClientServerTimestampResponse                            EMsg = 9803
+	EMsg_ServiceMethodCallFromClientNonAuthed                     EMsg = 9804
 	EMsg_ClientLANP2PBase                                         EMsg = 9900
 	EMsg_ClientLANP2PRequestChunk                                 EMsg = 9900
 	EMsg_ClientLANP2PRequestChunkResponse                         EMsg = 9901
@@ -3472,6 +3473,7 @@ var EMsg_name = map[EMsg]string{
 	9801:  "EMsg_ClientVoiceCallPreAuthorizeResponse",
 	9802:  "EMsg_ClientServerTimestampRequest",
 	9803:  "EMsg_ClientServerTimestampResponse",
+	9804:  "EMsg_ServiceMethodCallFromClientNonAuthed",
 	9900:  "EMsg_ClientLANP2PBase",
 	9901:  "EMsg_ClientLANP2PRequestChunkResponse",
 	9999:  "EMsg_ClientLANP2PMax",
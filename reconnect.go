@@ -0,0 +1,301 @@
+package steam
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/netutil"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+)
+
+type ReconnectOptions struct {
+	// The delay before the first attempt. It is doubled for every failed attempt. Defaults to one second.
+	MinBackoff time.Duration
+	// The maximum delay between two attempts. Defaults to two minutes.
+	MaxBackoff time.Duration
+	// The number of attempts after which the supervisor gives up. Zero means no limit.
+	MaxAttempts int
+	// The time a single attempt may take, including the logon. Defaults to 30 seconds.
+	AttemptTimeout time.Duration
+	// How long a server that failed an attempt is not used again. Defaults to five minutes.
+	BlacklistDuration time.Duration
+
+	// The servers to connect to. If empty, the Steam Directory is used, falling back to CMServers.
+	Servers []string
+	// If set, it is called before every attempt and the servers it returns are used instead of Servers,
+	// so that the list can change while the supervisor is running. An empty list is handled like empty Servers.
+	ServerList func() []string
+	// If true, connect to WebSocket CM servers instead of TCP ones.
+	WebSocket bool
+	// If true, the client is not logged on again after reconnecting.
+	// Use this if you are logging on in response to ConnectedEvent yourself.
+	SkipLogOn bool
+}
+
+// Turns on automatic reconnection. Whenever the connection is lost without calling Disconnect,
+// the client reconnects with a jittered exponential backoff, rotating through the available servers
+// and skipping servers that failed recently. Afterwards, it logs on again like the last logon,
+// using the login key instead of the password if one was received.
+//
+// A ReconnectingEvent is emitted before every attempt, and a ReconnectedEvent or ReconnectFailedEvent
+// when the supervisor is done. If opts is nil, the defaults are used.
+func (c *Client) EnableAutoReconnect(opts *ReconnectOptions) {
+	if opts == nil {
+		opts = new(ReconnectOptions)
+	}
+	r := newReconnector(c, *opts)
+
+	c.reconnectMutex.Lock()
+	if c.reconnector != nil {
+		c.reconnector.stop()
+	}
+	c.reconnector = r
+	c.reconnectMutex.Unlock()
+
+	go r.run()
+}
+
+// Turns off automatic reconnection and stops any reconnect that is in progress.
+func (c *Client) DisableAutoReconnect() {
+	c.reconnectMutex.Lock()
+	if c.reconnector != nil {
+		c.reconnector.stop()
+		c.reconnector = nil
+	}
+	c.reconnectMutex.Unlock()
+}
+
+type reconnector struct {
+	client *Client
+	opts   ReconnectOptions
+	sub    *Subscription
+	rng    *rand.Rand
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	blacklist map[string]time.Time
+}
+
+func newReconnector(client *Client, opts ReconnectOptions) *reconnector {
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 2 * time.Minute
+	}
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = opts.MinBackoff
+	}
+	if opts.AttemptTimeout <= 0 {
+		opts.AttemptTimeout = 30 * time.Second
+	}
+	if opts.BlacklistDuration <= 0 {
+		opts.BlacklistDuration = 5 * time.Minute
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &reconnector{
+		client: client,
+		opts:   opts,
+		sub: client.Subscribe(&SubscribeOptions{
+			Buffer:   1,
+			Overflow: OverflowDropNewest,
+			Filter: func(event interface{}) bool {
+				e, ok := event.(*DisconnectedEvent)
+				return ok && !e.UserInitiated
+			},
+		}),
+		rng:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		ctx:       ctx,
+		cancel:    cancel,
+		blacklist: make(map[string]time.Time),
+	}
+}
+
+func (r *reconnector) stop() {
+	r.cancel()
+	r.sub.Unsubscribe()
+}
+
+func (r *reconnector) run() {
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case _, ok := <-r.sub.Events():
+			if !ok {
+				return
+			}
+		}
+
+		for {
+			ok := r.reconnect()
+			// our own failed attempts emit DisconnectedEvents as well
+			r.drain()
+			if !ok || r.client.Connected() {
+				break
+			}
+		}
+	}
+}
+
+func (r *reconnector) drain() {
+	for {
+		select {
+		case <-r.sub.Events():
+		default:
+			return
+		}
+	}
+}
+
+// Tries to reconnect until an attempt succeeds or the supervisor gives up.
+// Returns true if the client was reconnected.
+func (r *reconnector) reconnect() bool {
+	var err error
+	attempts := 0
+	for attempt := 1; r.opts.MaxAttempts == 0 || attempt <= r.opts.MaxAttempts; attempt++ {
+		attempts = attempt
+		server := r.nextServer()
+		delay := r.backoff(attempt)
+		r.client.log(LogClient).Info("Reconnecting", "attempt", attempt, "delay", delay, "server", server)
+		r.client.Emit(&ReconnectingEvent{
+			Attempt: attempt,
+			Delay:   delay,
+			Server:  server,
+		})
+
+		select {
+		case <-time.After(delay):
+		case <-r.ctx.Done():
+			return false
+		}
+
+		if server == "" {
+			err = errors.New("steam: no servers to reconnect to")
+			continue
+		}
+		err = r.attempt(server)
+		if err == nil {
+			r.client.Emit(&ReconnectedEvent{
+				Attempts: attempt,
+				Server:   server,
+			})
+			return true
+		}
+		if r.ctx.Err() != nil {
+			return false
+		}
+		r.client.log(LogClient).Warn("Reconnect attempt failed", "attempt", attempt, "server", server, "error", err)
+		r.blacklist[server] = time.Now().Add(r.opts.BlacklistDuration)
+
+		var resultErr *EResultError
+		if errors.As(err, &resultErr) && !isTransientLogOnResult(resultErr.Result) {
+			break
+		}
+	}
+
+	r.client.log(LogClient).Error("Giving up reconnecting", "attempts", attempts, "error", err)
+	r.client.Emit(&ReconnectFailedEvent{
+		Attempts: attempts,
+		Err:      err,
+	})
+	return false
+}
+
+func (r *reconnector) attempt(server string) error {
+	ctx, cancel := context.WithTimeout(r.ctx, r.opts.AttemptTimeout)
+	defer cancel()
+
+	c := r.client
+	err := c.connectContext(ctx, func() error {
+		if r.opts.WebSocket {
+			return c.connectToWebSocket(ctx, server)
+		}
+		addr := netutil.ParsePortAddr(server)
+		if addr == nil {
+			return errors.New("steam: invalid server address " + server)
+		}
+		return c.connectToBind(ctx, addr, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	logOn := c.Auth.relogon()
+	if r.opts.SkipLogOn || logOn == nil {
+		return nil
+	}
+	if err = c.Auth.waitForLogOn(ctx, logOn); err != nil {
+		c.Disconnect()
+		return err
+	}
+	return nil
+}
+
+// Returns the delay before the given attempt: the exponential backoff with a random jitter
+// of up to half of it, so that many clients don't reconnect at the same time.
+func (r *reconnector) backoff(attempt int) time.Duration {
+	delay := r.opts.MaxBackoff
+	if attempt < 32 {
+		if d := r.opts.MinBackoff << uint(attempt-1); d > 0 && d < delay {
+			delay = d
+		}
+	}
+	return delay/2 + time.Duration(r.rng.Int63n(int64(delay/2)+1))
+}
+
+// Returns a random server that isn't blacklisted. If all of them are, the blacklist is cleared.
+func (r *reconnector) nextServer() string {
+	servers := r.servers()
+	now := time.Now()
+	var candidates []string
+	for _, server := range servers {
+		if until, ok := r.blacklist[server]; !ok || now.After(until) {
+			candidates = append(candidates, server)
+		}
+	}
+	if len(candidates) == 0 {
+		r.blacklist = make(map[string]time.Time)
+		candidates = servers
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[r.rng.Intn(len(candidates))]
+}
+
+func (r *reconnector) servers() []string {
+	servers := r.opts.Servers
+	if r.opts.ServerList != nil {
+		servers = r.opts.ServerList()
+	}
+	if len(servers) > 0 {
+		return servers
+	}
+	if !steamDirectoryCache.IsInitialized() {
+		_ = steamDirectoryCache.Initialize(r.client.httpClient())
+	}
+	if steamDirectoryCache.IsInitialized() {
+		if servers := steamDirectoryCache.GetServers(r.opts.WebSocket); len(servers) > 0 {
+			return servers
+		}
+	}
+	if r.opts.WebSocket {
+		return nil
+	}
+	return CMServers
+}
+
+// Returns true for logon results that are caused by a problem on Steam's side.
+func isTransientLogOnResult(result steamlang.EResult) bool {
+	switch result {
+	case steamlang.EResult_Fail, steamlang.EResult_ServiceUnavailable, steamlang.EResult_TryAnotherCM,
+		steamlang.EResult_Timeout, steamlang.EResult_Busy:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAutoReconnect(t *testing.T) {
+	logons := make(chan *protobuf.CMsgClientLogon, 2)
+	var connections int32
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		n := atomic.AddInt32(&connections, 1)
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		packet, err := protocol.NewPacket(data)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		logon := new(protobuf.CMsgClientLogon)
+		packet.ReadProtoMsg(logon)
+		logons <- logon
+
+		buf := new(bytes.Buffer)
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+			Eresult:                   proto.Int32(int32(steamlang.EResult_OK)),
+			OutOfGameHeartbeatSeconds: proto.Int32(60),
+		}).Serialize(buf)
+		conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		if n == 1 {
+			// drop the first connection
+			return
+		}
+		conn.ReadMessage()
+	})
+
+	client := NewClient()
+	client.IgnoreEvents()
+	sub := client.Subscribe(&SubscribeOptions{Buffer: 64})
+	client.EnableAutoReconnect(&ReconnectOptions{
+		MinBackoff: 10 * time.Millisecond,
+		Servers:    []string{url},
+		WebSocket:  true,
+	})
+	defer client.DisableAutoReconnect()
+
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := client.Auth.LogOnContext(ctx, &LogOnDetails{
+		Username:      "gopher",
+		Password:      "hunter2",
+		TwoFactorCode: "ABCDE",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-logons
+
+	for {
+		select {
+		case event := <-sub.Events():
+			switch e := event.(type) {
+			case *ReconnectedEvent:
+				logon := <-logons
+				if logon.GetAccountName() != "gopher" || logon.GetPassword() != "hunter2" {
+					t.Fatalf("Expected to log on again with the same credentials, got %v", logon)
+				}
+				if logon.TwoFactorCode != nil {
+					t.Fatal("Two-factor codes must not be reused")
+				}
+				return
+			case *ReconnectFailedEvent:
+				t.Fatalf("Reconnect failed: %v", e.Err)
+			}
+		case <-ctx.Done():
+			t.Fatal("Timed out waiting for a ReconnectedEvent")
+		}
+	}
+}
+
+func TestReconnectBackoff(t *testing.T) {
+	r := newReconnector(NewClient(), ReconnectOptions{
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+	})
+	defer r.stop()
+
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		delay := r.backoff(attempt + 1)
+		if delay < max/2 || delay > max {
+			t.Fatalf("Expected the delay for attempt %v to be between %v and %v, got %v", attempt+1, max/2, max, delay)
+		}
+	}
+	if delay := r.backoff(100); delay > 10*time.Second {
+		t.Fatalf("Expected the delay to be capped, got %v", delay)
+	}
+}
+
+func TestReconnectServerList(t *testing.T) {
+	servers := []string{"1.2.3.4:27017"}
+	r := newReconnector(NewClient(), ReconnectOptions{
+		Servers: []string{"5.6.7.8:27017"},
+		ServerList: func() []string {
+			return servers
+		},
+	})
+	defer r.stop()
+
+	if server := r.nextServer(); server != "1.2.3.4:27017" {
+		t.Fatalf("Expected the server from ServerList, got %v", server)
+	}
+	servers = []string{"9.9.9.9:27017"}
+	if server := r.nextServer(); server != "9.9.9.9:27017" {
+		t.Fatalf("Expected the updated server list to be used, got %v", server)
+	}
+}
@@ -2,6 +2,7 @@ package steam
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"io"
 	"sync"
@@ -161,6 +162,20 @@ func (s *Social) RequestProfileInfo(id steamid.SteamId) {
 	}))
 }
 
+// Requests profile information for a specified SteamId and waits for the response.
+// A ProfileInfoEvent is emitted as well.
+func (s *Social) GetProfileInfo(ctx context.Context, id steamid.SteamId) (*ProfileInfoEvent, error) {
+	packet, err := s.client.Call(ctx, protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientFriendProfileInfo, &protobuf.CMsgClientFriendProfileInfo{
+		SteamidFriend: proto.Uint64(id.ToUint64()),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	body := new(protobuf.CMsgClientFriendProfileInfoResponse)
+	packet.ReadProtoMsg(body)
+	return newProfileInfoEvent(body), nil
+}
+
 // Requests all offline messages and marks them as read
 func (s *Social) RequestOfflineMessages() {
 	s.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientChatGetFriendMessageHistoryForOfflineMessages, &protobuf.CMsgClientChatGetFriendMessageHistoryForOfflineMessages{}))
@@ -591,7 +606,11 @@ func (s *Social) handleIgnoreFriendResponse(packet *protocol.Packet) {
 func (s *Social) handleProfileInfoResponse(packet *protocol.Packet) {
 	body := new(protobuf.CMsgClientFriendProfileInfoResponse)
 	packet.ReadProtoMsg(body)
-	s.client.Emit(&ProfileInfoEvent{
+	s.client.Emit(newProfileInfoEvent(body))
+}
+
+func newProfileInfoEvent(body *protobuf.CMsgClientFriendProfileInfoResponse) *ProfileInfoEvent {
+	return &ProfileInfoEvent{
 		Result:      steamlang.EResult(body.GetEresult()),
 		SteamId:     steamid.SteamId(body.GetSteamidFriend()),
 		TimeCreated: body.GetTimeCreated(),
@@ -601,7 +620,7 @@ func (s *Social) handleProfileInfoResponse(packet *protocol.Packet) {
 		CountryName: body.GetCountryName(),
 		Headline:    body.GetHeadline(),
 		Summary:     body.GetSummary(),
-	})
+	}
 }
 
 func (s *Social) handleFriendMessageHistoryResponse(packet *protocol.Packet) {
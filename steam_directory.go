@@ -15,22 +15,28 @@ import (
 // Call InitializeSteamDirectory() before Connect() to use
 // steam directory server list instead of static one.
 func InitializeSteamDirectory() error {
-	return steamDirectoryCache.Initialize()
+	return steamDirectoryCache.Initialize(http.DefaultClient)
+}
+
+// Like InitializeSteamDirectory, but uses the given client for the request, for example
+// to go through the same proxy as the connections to Steam.
+func InitializeSteamDirectoryWithClient(client *http.Client) error {
+	return steamDirectoryCache.Initialize(client)
 }
 
 var steamDirectoryCache *steamDirectory = &steamDirectory{}
 
 type steamDirectory struct {
 	sync.RWMutex
-	servers       []string
-	isInitialized bool
+	servers          []string
+	websocketServers []string
+	isInitialized    bool
 }
 
 // Get server list from steam directory and save it for later
-func (sd *steamDirectory) Initialize() error {
+func (sd *steamDirectory) Initialize(client *http.Client) error {
 	sd.Lock()
 	defer sd.Unlock()
-	client := new(http.Client)
 	resp, err := client.Get(fmt.Sprintf("https://api.steampowered.com/ISteamDirectory/GetCMList/v1/?cellId=0"))
 	if err != nil {
 		return err
@@ -38,9 +44,10 @@ func (sd *steamDirectory) Initialize() error {
 	defer resp.Body.Close()
 	r := struct {
 		Response struct {
-			ServerList []string
-			Result     uint32
-			Message    string
+			ServerList           []string
+			ServerListWebsockets []string `json:"serverlist_websockets"`
+			Result               uint32
+			Message              string
 		}
 	}{}
 	if err = json.NewDecoder(resp.Body).Decode(&r); err != nil {
@@ -53,6 +60,7 @@ func (sd *steamDirectory) Initialize() error {
 		return fmt.Errorf("Steam returned zero servers for steam directory request\n")
 	}
 	sd.servers = r.Response.ServerList
+	sd.websocketServers = r.Response.ServerListWebsockets
 	sd.isInitialized = true
 	return nil
 }
@@ -68,6 +76,32 @@ func (sd *steamDirectory) GetRandomCM() *netutil.PortAddr {
 	return addr
 }
 
+// Returns the host and port of a random WebSocket CM server, or an error if the
+// Steam Directory didn't return any.
+func (sd *steamDirectory) GetRandomWebSocketCM() (string, error) {
+	sd.RLock()
+	defer sd.RUnlock()
+	if !sd.isInitialized {
+		panic("steam directory is not initialized")
+	}
+	if len(sd.websocketServers) == 0 {
+		return "", fmt.Errorf("steam: steam directory returned no WebSocket servers")
+	}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	return sd.websocketServers[rng.Int31n(int32(len(sd.websocketServers)))], nil
+}
+
+// Returns a copy of the list of CM servers, or of the WebSocket CM servers if websocket is true.
+func (sd *steamDirectory) GetServers(websocket bool) []string {
+	sd.RLock()
+	defer sd.RUnlock()
+	servers := sd.servers
+	if websocket {
+		servers = sd.websocketServers
+	}
+	return append([]string(nil), servers...)
+}
+
 func (sd *steamDirectory) IsInitialized() bool {
 	sd.RLock()
 	defer sd.RUnlock()
@@ -1,7 +1,6 @@
 package steamid
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -35,14 +34,12 @@ func NewId(id string) (SteamId, error) {
 		accountType := int32(1) //EAccountType_Individual
 		accountId := (uint32(accId) << 1) | uint32(authServer)
 		return NewIdAdv(uint32(accountId), 1, int32(universe), accountType), nil
-	} else {
-		newid, err := strconv.ParseUint(id, 10, 64)
-		if err != nil {
-			return SteamId(0), err
-		}
-		return SteamId(newid), nil
 	}
-	return SteamId(0), errors.New(fmt.Sprintf("Invalid SteamId: %s\n", id))
+	newid, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return SteamId(0), err
+	}
+	return SteamId(newid), nil
 }
 
 func NewIdAdv(accountId, instance uint32, universe int32, accountType int32) SteamId {
@@ -0,0 +1,334 @@
+package steamtest
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/cryptoutil"
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Returned by Accept and Expect if nothing arrived in time.
+var ErrTimeout = errors.New("steamtest: timed out")
+
+// Returned by Expect if the connection was closed.
+var ErrClosed = errors.New("steamtest: connection closed")
+
+const connectionMagic uint32 = 0x31305456 // "VT01"
+
+// A connection from a client to a Server.
+type Conn struct {
+	server *Server
+	conn   net.Conn
+
+	writeMutex sync.Mutex // guarding ciph and hmacSecret
+	ciph       cipher.Block
+	hmacSecret []byte
+
+	mutex    sync.Mutex // guarding received, notify and closed
+	received []*protocol.Packet
+	notify   chan struct{}
+	closed   bool
+
+	sessionId int32
+}
+
+func newConn(server *Server, conn net.Conn) *Conn {
+	var sessionId int32
+	binary.Read(rand.Reader, binary.LittleEndian, &sessionId)
+	return &Conn{
+		server:    server,
+		conn:      conn,
+		notify:    make(chan struct{}),
+		sessionId: sessionId,
+	}
+}
+
+// The session id that is assigned to the client on logon.
+func (c *Conn) SessionId() int32 {
+	return c.sessionId
+}
+
+// Closes the connection. The client sees this as a lost connection.
+func (c *Conn) Close() error {
+	c.mutex.Lock()
+	if !c.closed {
+		c.closed = true
+		close(c.notify)
+	}
+	c.mutex.Unlock()
+	return c.conn.Close()
+}
+
+// Sends a message to the client. Client messages are sent with the Steam ID and session id the client logged on with.
+func (c *Conn) Send(msg protocol.IMsg) error {
+	if cm, ok := msg.(protocol.IClientMsg); ok {
+		cm.SetSteamId(c.server.steamId())
+		cm.SetSessionId(c.sessionId)
+	}
+	buf := new(bytes.Buffer)
+	if err := msg.Serialize(buf); err != nil {
+		return err
+	}
+	return c.write(buf.Bytes())
+}
+
+// Sends a protobuf message of the given type to the client.
+func (c *Conn) SendProto(eMsg steamlang.EMsg, body proto.Message) error {
+	return c.Send(protocol.NewClientMsgProtobuf(eMsg, body))
+}
+
+// Sends a protobuf message as the response to a job the client started.
+func (c *Conn) Reply(request *protocol.Packet, eMsg steamlang.EMsg, body proto.Message) error {
+	msg := protocol.NewClientMsgProtobuf(eMsg, body)
+	msg.SetTargetJobId(request.SourceJobId)
+	return c.Send(msg)
+}
+
+// Sends the full friends list with the given accounts as friends.
+func (c *Conn) SendFriendsList(friends ...steamid.SteamId) error {
+	list := &protobuf.CMsgClientFriendsList{
+		Bincremental: proto.Bool(false),
+	}
+	for _, id := range friends {
+		list.Friends = append(list.Friends, &protobuf.CMsgClientFriendsList_Friend{
+			Ulfriendid:          proto.Uint64(uint64(id)),
+			Efriendrelationship: proto.Uint32(uint32(steamlang.EFriendRelationship_Friend)),
+		})
+	}
+	return c.SendProto(steamlang.EMsg_ClientFriendsList, list)
+}
+
+// Sends the name and state of a friend.
+func (c *Conn) SendPersonaState(id steamid.SteamId, name string, state steamlang.EPersonaState) error {
+	return c.SendProto(steamlang.EMsg_ClientPersonaState, &protobuf.CMsgClientPersonaState{
+		StatusFlags: proto.Uint32(uint32(steamlang.EClientPersonaStateFlag_PlayerName | steamlang.EClientPersonaStateFlag_Presence)),
+		Friends: []*protobuf.CMsgClientPersonaState_Friend{{
+			Friendid:     proto.Uint64(uint64(id)),
+			PlayerName:   proto.String(name),
+			PersonaState: proto.Uint32(uint32(state)),
+		}},
+	})
+}
+
+// Sends a chat message from a friend.
+func (c *Conn) SendChatMessage(from steamid.SteamId, message string) error {
+	return c.SendProto(steamlang.EMsg_ClientFriendMsgIncoming, &protobuf.CMsgClientFriendMsgIncoming{
+		SteamidFrom:            proto.Uint64(uint64(from)),
+		ChatEntryType:          proto.Int32(int32(steamlang.EChatEntryType_ChatMsg)),
+		Message:                append([]byte(message), 0),
+		Rtime32ServerTimestamp: proto.Uint32(uint32(time.Now().Unix())),
+	})
+}
+
+// Waits for the next packet of the given type that the client sent and that wasn't returned before.
+// Packets of other types are kept, so the order of calls doesn't need to match the order of packets.
+func (c *Conn) Expect(eMsg steamlang.EMsg) (*protocol.Packet, error) {
+	timeout := time.After(c.server.timeout())
+	for {
+		c.mutex.Lock()
+		for i, packet := range c.received {
+			if packet.EMsg == eMsg {
+				c.received = append(c.received[:i:i], c.received[i+1:]...)
+				c.mutex.Unlock()
+				return packet, nil
+			}
+		}
+		notify, closed := c.notify, c.closed
+		c.mutex.Unlock()
+		if closed {
+			return nil, ErrClosed
+		}
+
+		select {
+		case <-notify:
+		case <-timeout:
+			return nil, ErrTimeout
+		}
+	}
+}
+
+// Like Expect, but also decodes the protobuf body of the packet.
+func (c *Conn) ExpectProto(eMsg steamlang.EMsg, body proto.Message) (*protocol.Packet, error) {
+	packet, err := c.Expect(eMsg)
+	if err != nil {
+		return nil, err
+	}
+	if !packet.IsProto {
+		return nil, fmt.Errorf("steamtest: %v is not a protobuf message", eMsg)
+	}
+	packet.ReadProtoMsg(body)
+	return packet, nil
+}
+
+func (c *Conn) record(packet *protocol.Packet) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.closed {
+		return
+	}
+	c.received = append(c.received, packet)
+	close(c.notify)
+	c.notify = make(chan struct{})
+}
+
+func (c *Conn) readLoop() {
+	defer c.Close()
+	for {
+		packet, err := c.read()
+		if err != nil {
+			return
+		}
+		c.record(packet)
+		if handler := c.server.handler(packet.EMsg); handler != nil {
+			handler(c, packet)
+		}
+	}
+}
+
+// Performs the channel encryption handshake.
+func (c *Conn) handshake() error {
+	request := steamlang.NewMsgChannelEncryptRequest()
+	request.Universe = c.server.universe()
+	var challenge []byte
+	if !c.server.LegacyEncryption {
+		challenge = make([]byte, 16)
+		rand.Read(challenge)
+	}
+	if err := c.Send(protocol.NewMsg(request, challenge)); err != nil {
+		return err
+	}
+
+	packet, err := c.read()
+	if err != nil {
+		return err
+	}
+	if packet.EMsg != steamlang.EMsg_ChannelEncryptResponse {
+		return fmt.Errorf("steamtest: expected ChannelEncryptResponse, got %v", packet.EMsg)
+	}
+	payload := packet.ReadMsg(steamlang.NewMsgChannelEncryptResponse()).Payload
+	if len(payload) < c.server.key.Size()+4 {
+		return errors.New("steamtest: ChannelEncryptResponse is too short")
+	}
+	encryptedKey := payload[:c.server.key.Size()]
+	if crc := binary.LittleEndian.Uint32(payload[len(encryptedKey):]); crc != crc32.ChecksumIEEE(encryptedKey) {
+		return errors.New("steamtest: invalid checksum of the session key")
+	}
+	blob, err := rsa.DecryptOAEP(sha1.New(), nil, c.server.key, encryptedKey, nil)
+	if err != nil {
+		return err
+	}
+	if len(blob) != 32+len(challenge) || !bytes.Equal(blob[32:], challenge) {
+		return errors.New("steamtest: the session key does not contain the challenge")
+	}
+	sessionKey := blob[:32]
+	ciph, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return err
+	}
+
+	result := steamlang.NewMsgChannelEncryptResult()
+	result.Result = steamlang.EResult_OK
+	if err = c.Send(protocol.NewMsg(result, nil)); err != nil {
+		return err
+	}
+	c.writeMutex.Lock()
+	c.ciph = ciph
+	if challenge != nil {
+		c.hmacSecret = sessionKey[:16]
+	}
+	c.writeMutex.Unlock()
+	return nil
+}
+
+// Reads a packet. This is only called from the goroutine that serves the connection.
+func (c *Conn) read() (*protocol.Packet, error) {
+	var header struct {
+		Length uint32
+		Magic  uint32
+	}
+	if err := binary.Read(c.conn, binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+	if header.Magic != connectionMagic {
+		return nil, fmt.Errorf("steamtest: invalid connection magic %x", header.Magic)
+	}
+	buf := make([]byte, header.Length)
+	if _, err := io.ReadFull(c.conn, buf); err != nil {
+		return nil, err
+	}
+
+	c.writeMutex.Lock()
+	ciph, hmacSecret := c.ciph, c.hmacSecret
+	c.writeMutex.Unlock()
+	if ciph != nil && hmacSecret != nil {
+		var err error
+		if buf, err = cryptoutil.SymmetricDecryptWithHMACIV(ciph, buf, hmacSecret); err != nil {
+			return nil, err
+		}
+	} else if ciph != nil {
+		buf = cryptoutil.SymmetricDecrypt(ciph, buf)
+	}
+	return protocol.NewPacket(buf)
+}
+
+func (c *Conn) write(message []byte) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	if c.ciph != nil && c.hmacSecret != nil {
+		message = cryptoutil.SymmetricEncryptWithHMACIV(c.ciph, message, c.hmacSecret)
+	} else if c.ciph != nil {
+		message = cryptoutil.SymmetricEncrypt(c.ciph, message)
+	}
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.LittleEndian, uint32(len(message)))
+	binary.Write(buf, binary.LittleEndian, connectionMagic)
+	buf.Write(message)
+	_, err := c.conn.Write(buf.Bytes())
+	return err
+}
+
+// The default handler for ClientLogon.
+func handleLogOn(c *Conn, packet *protocol.Packet) {
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientLogOnResponse, &protobuf.CMsgClientLogonResponse{
+		Eresult:                   proto.Int32(int32(c.server.LogOnResult)),
+		OutOfGameHeartbeatSeconds: proto.Int32(c.server.heartbeatSeconds()),
+		InGameHeartbeatSeconds:    proto.Int32(c.server.heartbeatSeconds()),
+		Rtime32ServerTime:         proto.Uint32(uint32(time.Now().Unix())),
+		ClientSuppliedSteamid:     proto.Uint64(uint64(c.server.steamId())),
+	})
+	c.Send(msg)
+}
+
+// The default handler for ClientLogOff.
+func handleLogOff(c *Conn, packet *protocol.Packet) {
+	c.SendProto(steamlang.EMsg_ClientLoggedOff, &protobuf.CMsgClientLoggedOff{
+		Eresult: proto.Int32(int32(steamlang.EResult_OK)),
+	})
+}
+
+// The default handler for ClientServerTimestampRequest.
+func handleServerTimestampRequest(c *Conn, packet *protocol.Packet) {
+	request := new(protobuf.CMsgClientServerTimestampRequest)
+	packet.ReadProtoMsg(request)
+	c.Reply(packet, steamlang.EMsg_ClientServerTimestampResponse, &protobuf.CMsgClientServerTimestampResponse{
+		ClientRequestTimestamp: proto.Uint64(request.GetClientRequestTimestamp()),
+		ServerTimestampMs:      proto.Uint64(uint64(time.Now().UnixNano() / int64(time.Millisecond))),
+	})
+}
@@ -0,0 +1,250 @@
+/*
+Package steamtest provides a fake Steam CM server for tests that don't need a live Steam account.
+
+The server listens on a local TCP port and speaks the same protocol as the real CM servers: it frames
+packets with VT01, performs the channel encryption handshake with HMAC-verified IVs (or the legacy
+mode if LegacyEncryption is set) and answers ClientLogon. Tests can then
+push packets to the client and assert on the packets the client sent.
+
+	server := steamtest.NewServer()
+	defer server.Close()
+
+	client := server.NewClient()
+	client.ConnectTo(server.Addr())
+	conn, err := server.Accept()
+	// ...
+	conn.SendChatMessage(friendId, "hello")
+	packet, err := conn.Expect(steamlang.EMsg_ClientFriendMsg)
+
+The server has its own RSA key, so the client has to encrypt its session key with it instead of the public key
+of the universe. Create the client with Server.NewClient or set it up with Server.Configure; other clients of the
+process are not affected.
+*/
+package steamtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3"
+	"github.com/Philipp15b/go-steam/v3/netutil"
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+)
+
+// The time Accept and Expect wait if Server.Timeout is not set.
+const DefaultTimeout = 5 * time.Second
+
+// The Steam ID of the account that logs on to a Server if Server.SteamId is not set.
+const DefaultSteamId steamid.SteamId = 76561197960287930
+
+// Handles a packet sent by the client. See Server.Handle.
+type HandlerFunc func(conn *Conn, packet *protocol.Packet)
+
+// A fake CM server. All methods are safe for concurrent use.
+type Server struct {
+	// The settings may only be changed before the server is started, see NewUnstartedServer.
+
+	// The time Accept and Expect wait. Defaults to DefaultTimeout.
+	Timeout time.Duration
+	// The Steam ID that is assigned to the client on logon. Defaults to DefaultSteamId.
+	SteamId steamid.SteamId
+	// The result that ClientLogon is answered with. Defaults to EResult_OK.
+	LogOnResult steamlang.EResult
+	// If true, no challenge is sent during the handshake, so that the legacy
+	// channel encryption without HMAC-verified IVs is used.
+	LegacyEncryption bool
+	// The heartbeat interval in seconds that is sent to the client on logon. Defaults to nine like on Steam.
+	HeartbeatSeconds int32
+	// The universe the server claims to be in. Defaults to EUniverse_Public.
+	// The default Steam ID is moved to it.
+	Universe steamlang.EUniverse
+
+	listener net.Listener
+	key      *rsa.PrivateKey
+	conns    chan *Conn
+
+	mutex    sync.Mutex // guarding handlers and accepted
+	handlers map[steamlang.EMsg]HandlerFunc
+	accepted []*Conn
+
+	wg sync.WaitGroup
+}
+
+var (
+	testKeyOnce sync.Once
+	testKey     *rsa.PrivateKey
+)
+
+// Returns the test key that is shared by all servers, since generating one is slow.
+func getTestKey() *rsa.PrivateKey {
+	testKeyOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			panic(err)
+		}
+		testKey = key
+	})
+	return testKey
+}
+
+// Starts a new server on a random local port. It must be closed with Close.
+func NewServer() *Server {
+	s := NewUnstartedServer()
+	s.Start()
+	return s
+}
+
+// Returns a new server that listens on a random local port, but doesn't accept connections
+// until Start is called. The exported fields may only be changed before that.
+func NewUnstartedServer() *Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic("steamtest: failed to listen: " + err.Error())
+	}
+	s := &Server{
+		LogOnResult: steamlang.EResult_OK,
+		listener:    listener,
+		key:         getTestKey(),
+		conns:       make(chan *Conn, 16),
+		handlers:    make(map[steamlang.EMsg]HandlerFunc),
+	}
+	s.handlers[steamlang.EMsg_ClientLogon] = handleLogOn
+	s.handlers[steamlang.EMsg_ClientLogOff] = handleLogOff
+	s.handlers[steamlang.EMsg_ClientServerTimestampRequest] = handleServerTimestampRequest
+	return s
+}
+
+// Starts accepting connections.
+func (s *Server) Start() {
+	s.wg.Add(1)
+	go s.serve()
+}
+
+// Returns the public key the client has to encrypt its session key with.
+func (s *Server) PublicKey() *rsa.PublicKey {
+	return &s.key.PublicKey
+}
+
+// Sets up the client to connect to this server by setting its universe and public key.
+// It can be used as manager.Account.Configure.
+func (s *Server) Configure(client *steam.Client) {
+	client.Universe = s.universe()
+	client.PublicKey = s.PublicKey()
+}
+
+// Returns a new client that is set up with Configure.
+func (s *Server) NewClient() *steam.Client {
+	client := steam.NewClient()
+	s.Configure(client)
+	return client
+}
+
+// Returns the address clients can connect to with Client.ConnectTo.
+func (s *Server) Addr() *netutil.PortAddr {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return &netutil.PortAddr{
+		IP:   addr.IP,
+		Port: uint16(addr.Port),
+	}
+}
+
+// Sets the handler that is called for packets of the given type instead of the default one.
+// By default, ClientLogon, ClientLogOff and ClientServerTimestampRequest are answered. Packets are recorded for Expect in any case.
+// A nil handler removes the handler.
+func (s *Server) Handle(eMsg steamlang.EMsg, handler HandlerFunc) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if handler == nil {
+		delete(s.handlers, eMsg)
+		return
+	}
+	s.handlers[eMsg] = handler
+}
+
+func (s *Server) handler(eMsg steamlang.EMsg) HandlerFunc {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.handlers[eMsg]
+}
+
+// Waits for the next client to connect and finish the channel encryption handshake.
+func (s *Server) Accept() (*Conn, error) {
+	select {
+	case conn := <-s.conns:
+		return conn, nil
+	case <-time.After(s.timeout()):
+		return nil, ErrTimeout
+	}
+}
+
+// Stops listening and closes all connections.
+func (s *Server) Close() {
+	s.listener.Close()
+	s.mutex.Lock()
+	for _, conn := range s.accepted {
+		conn.Close()
+	}
+	s.mutex.Unlock()
+	s.wg.Wait()
+}
+
+func (s *Server) timeout() time.Duration {
+	if s.Timeout > 0 {
+		return s.Timeout
+	}
+	return DefaultTimeout
+}
+
+func (s *Server) steamId() steamid.SteamId {
+	if s.SteamId != 0 {
+		return s.SteamId
+	}
+	return DefaultSteamId.SetAccountUniverse(int32(s.universe()))
+}
+
+func (s *Server) heartbeatSeconds() int32 {
+	if s.HeartbeatSeconds > 0 {
+		return s.HeartbeatSeconds
+	}
+	return 9
+}
+
+func (s *Server) universe() steamlang.EUniverse {
+	if s.Universe == steamlang.EUniverse_Invalid {
+		return steamlang.EUniverse_Public
+	}
+	return s.Universe
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		netConn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		conn := newConn(s, netConn)
+		s.mutex.Lock()
+		s.accepted = append(s.accepted, conn)
+		s.mutex.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			if err := conn.handshake(); err != nil {
+				conn.Close()
+				return
+			}
+			select {
+			case s.conns <- conn:
+			default: // nobody is accepting connections
+			}
+			conn.readLoop()
+		}()
+	}
+}
@@ -0,0 +1,257 @@
+package steamtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/Philipp15b/go-steam/v3/steamid"
+)
+
+const friendId steamid.SteamId = 76561197960287931
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	if steam.GetPublicKey(steamlang.EUniverse_Public).Equal(server.PublicKey()) {
+		t.Fatal("Expected the public key of the public universe to be kept")
+	}
+
+	client := server.NewClient()
+	defer client.Disconnect()
+	client.IgnoreEvents()
+	sub := client.Subscribe(&steam.SubscribeOptions{Buffer: 64})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.ConnectToContext(ctx, server.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := server.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.Auth.LogOnContext(ctx, &steam.LogOnDetails{
+		Username: "gopher",
+		Password: "hunter2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logon := new(protobuf.CMsgClientLogon)
+	if _, err = conn.ExpectProto(steamlang.EMsg_ClientLogon, logon); err != nil {
+		t.Fatal(err)
+	}
+	if logon.GetAccountName() != "gopher" {
+		t.Fatalf("Expected account name gopher, got %v", logon.GetAccountName())
+	}
+	if client.SteamId() != DefaultSteamId {
+		t.Fatalf("Expected Steam ID %v, got %v", DefaultSteamId, client.SteamId())
+	}
+
+	conn.SendFriendsList(friendId)
+	conn.SendPersonaState(friendId, "gordon", steamlang.EPersonaState_Online)
+	conn.SendChatMessage(friendId, "hello")
+	msg := waitForEvent(t, sub, func(event interface{}) bool {
+		_, ok := event.(*steam.ChatMsgEvent)
+		return ok
+	}).(*steam.ChatMsgEvent)
+	if msg.ChatterId != friendId || msg.Message != "hello" {
+		t.Fatalf("Unexpected chat message %+v", msg)
+	}
+	friend, err := client.Social.Friends.ById(friendId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if friend.Name != "gordon" {
+		t.Fatalf("Expected friend name gordon, got %v", friend.Name)
+	}
+
+	client.Social.SendMessage(friendId, steamlang.EChatEntryType_ChatMsg, "hi")
+	reply := new(protobuf.CMsgClientFriendMsg)
+	if _, err = conn.ExpectProto(steamlang.EMsg_ClientFriendMsg, reply); err != nil {
+		t.Fatal(err)
+	}
+	if string(reply.GetMessage()) != "hi" || reply.GetSteamid() != uint64(friendId) {
+		t.Fatalf("Unexpected message %v", reply)
+	}
+}
+
+func TestServerLegacyEncryptionAndLogOnFailure(t *testing.T) {
+	server := NewUnstartedServer()
+	defer server.Close()
+	server.LegacyEncryption = true
+	server.LogOnResult = steamlang.EResult_InvalidPassword
+	server.Start()
+
+	client := server.NewClient()
+	defer client.Disconnect()
+	client.IgnoreEvents()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.ConnectToContext(ctx, server.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	err := client.Auth.LogOnContext(ctx, &steam.LogOnDetails{
+		Username: "gopher",
+		Password: "wrong",
+	})
+	resultErr, ok := err.(*steam.EResultError)
+	if !ok || resultErr.Result != steamlang.EResult_InvalidPassword {
+		t.Fatalf("Expected EResult_InvalidPassword, got %v", err)
+	}
+}
+
+func TestServerUniverse(t *testing.T) {
+	server := NewUnstartedServer()
+	defer server.Close()
+	server.Universe = steamlang.EUniverse_Beta
+	server.Start()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	public := steam.NewClient()
+	defer public.Disconnect()
+	public.IgnoreEvents()
+	if err := public.ConnectToContext(ctx, server.Addr()); err == nil {
+		t.Fatal("Expected a client of the public universe to fail connecting to the beta universe")
+	}
+
+	client := server.NewClient()
+	defer client.Disconnect()
+	client.IgnoreEvents()
+	if err := client.ConnectToContext(ctx, server.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	err := client.Auth.LogOnContext(ctx, &steam.LogOnDetails{
+		Username: "gopher",
+		Password: "hunter2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if universe := steamlang.EUniverse(client.SteamId().GetAccountUniverse()); universe != steamlang.EUniverse_Beta {
+		t.Fatalf("Expected a Steam ID in the beta universe, got %v", universe)
+	}
+}
+
+func TestServerLogOff(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	client := server.NewClient()
+	defer client.Disconnect()
+	client.IgnoreEvents()
+	sub := client.Subscribe(&steam.SubscribeOptions{Buffer: 64})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.ConnectToContext(ctx, server.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := server.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Auth.LogOnContext(ctx, &steam.LogOnDetails{Username: "gopher", Password: "hunter2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	client.Social.SetPersonaState(steamlang.EPersonaState_Offline)
+	if err = client.Auth.LogOffContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if client.Connected() {
+		t.Fatal("Expected the client to be disconnected")
+	}
+	if _, err = conn.Expect(steamlang.EMsg_ClientChangeStatus); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.Expect(steamlang.EMsg_ClientLogOff); err != nil {
+		t.Fatal(err)
+	}
+	waitForEvent(t, sub, func(event interface{}) bool {
+		_, ok := event.(*steam.LoggedOffEvent)
+		return ok
+	})
+	disconnected := waitForEvent(t, sub, func(event interface{}) bool {
+		_, ok := event.(*steam.DisconnectedEvent)
+		return ok
+	}).(*steam.DisconnectedEvent)
+	if !disconnected.UserInitiated {
+		t.Fatal("Expected the disconnect to be user initiated")
+	}
+}
+
+func TestServerHeartbeat(t *testing.T) {
+	server := NewUnstartedServer()
+	defer server.Close()
+	server.HeartbeatSeconds = 1
+	server.Start()
+
+	client := server.NewClient()
+	defer client.Disconnect()
+	client.IgnoreEvents()
+	sub := client.Subscribe(&steam.SubscribeOptions{Buffer: 64})
+	client.ConnectionTimeout = 1500 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.ConnectToContext(ctx, server.Addr()); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := server.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Auth.LogOnContext(ctx, &steam.LogOnDetails{Username: "gopher", Password: "hunter2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = conn.Expect(steamlang.EMsg_ClientHeartBeat); err != nil {
+		t.Fatal(err)
+	}
+	for client.Latency() == 0 {
+		if ctx.Err() != nil {
+			t.Fatal("Latency was not measured")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// a server that doesn't answer anymore
+	server.Handle(steamlang.EMsg_ClientServerTimestampRequest, nil)
+	timeout := waitForEvent(t, sub, func(event interface{}) bool {
+		_, ok := event.(*steam.ConnectionTimeoutEvent)
+		return ok
+	}).(*steam.ConnectionTimeoutEvent)
+	if timeout.Idle < client.ConnectionTimeout {
+		t.Fatalf("Timed out after %v, expected at least %v", timeout.Idle, client.ConnectionTimeout)
+	}
+	disconnected := waitForEvent(t, sub, func(event interface{}) bool {
+		_, ok := event.(*steam.DisconnectedEvent)
+		return ok
+	}).(*steam.DisconnectedEvent)
+	if disconnected.UserInitiated {
+		t.Fatal("Expected the disconnect not to be user initiated")
+	}
+}
+
+func waitForEvent(t *testing.T, sub *steam.Subscription, match func(event interface{}) bool) interface{} {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-sub.Events():
+			if match(event) {
+				return event
+			}
+		case <-timeout:
+			t.Fatal("Timed out waiting for an event")
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package steam
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Determines what happens when an event is emitted while a subscriber's buffer is full.
+type OverflowPolicy int32
+
+const (
+	// Blocks the emitting goroutine until the subscriber has made room.
+	// Note that this stalls the whole client, including reading from the connection.
+	OverflowBlock OverflowPolicy = iota
+	// Discards the new event.
+	OverflowDropNewest
+	// Discards the oldest buffered event to make room for the new one.
+	OverflowDropOldest
+)
+
+// The number of events buffered for a subscriber if SubscribeOptions.Buffer is not set.
+const DefaultSubscriptionBuffer = 16
+
+type SubscribeOptions struct {
+	// The number of events that are buffered for this subscriber. Defaults to DefaultSubscriptionBuffer.
+	Buffer int
+	// What to do with new events while the buffer is full. Defaults to OverflowBlock.
+	Overflow OverflowPolicy
+	// If set, only events for which this function returns true are delivered.
+	// It is called from the emitting goroutine and must not block.
+	Filter func(event interface{}) bool
+}
+
+// A subscription to the events of a Client, created with Client.Subscribe or Client.On.
+// All methods are safe for concurrent use.
+type Subscription struct {
+	// 64 bit alignment
+	dropped uint64
+
+	client   *Client
+	overflow OverflowPolicy
+	filter   func(event interface{}) bool
+
+	events chan interface{}
+	done   chan struct{}
+	once   sync.Once
+
+	mutex  sync.RWMutex // guarding closed and sends to events
+	closed bool
+}
+
+// Returns the channel on which events are delivered. It is closed after Unsubscribe.
+func (s *Subscription) Events() <-chan interface{} {
+	return s.events
+}
+
+// Returns the number of events that were discarded because the buffer was full.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Stops the delivery of events and closes the events channel.
+// Events that are still buffered can be read until the channel is drained.
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		// unblocks pending sends before we wait for them
+		close(s.done)
+
+		s.client.subscriptionsMutex.Lock()
+		subs := s.client.subscriptions
+		for i, sub := range subs {
+			if sub == s {
+				s.client.subscriptions = append(subs[:i:i], subs[i+1:]...)
+				break
+			}
+		}
+		s.client.subscriptionsMutex.Unlock()
+
+		s.mutex.Lock()
+		s.closed = true
+		close(s.events)
+		s.mutex.Unlock()
+	})
+}
+
+func (s *Subscription) deliver(event interface{}) {
+	if s.filter != nil && !s.filter(event) {
+		return
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if s.closed {
+		return
+	}
+
+	switch s.overflow {
+	case OverflowBlock:
+		select {
+		case s.events <- event:
+		case <-s.done:
+		}
+	case OverflowDropNewest:
+		select {
+		case s.events <- event:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.events <- event:
+				return
+			default:
+			}
+			select {
+			case <-s.events:
+				atomic.AddUint64(&s.dropped, 1)
+			default:
+			}
+		}
+	}
+}
+
+// Creates a new subscription to the events emitted by this client.
+// If opts is nil, all events are delivered with the default buffer size and OverflowBlock.
+func (c *Client) Subscribe(opts *SubscribeOptions) *Subscription {
+	if opts == nil {
+		opts = new(SubscribeOptions)
+	}
+	buffer := opts.Buffer
+	if buffer <= 0 {
+		buffer = DefaultSubscriptionBuffer
+	}
+
+	s := &Subscription{
+		client:   c,
+		overflow: opts.Overflow,
+		filter:   opts.Filter,
+		events:   make(chan interface{}, buffer),
+		done:     make(chan struct{}),
+	}
+
+	c.subscriptionsMutex.Lock()
+	c.subscriptions = append(c.subscriptions, s)
+	c.subscriptionsMutex.Unlock()
+	return s
+}
+
+// Calls handler for every event that can be assigned to the type of its only parameter,
+// for example func(*LoggedOnEvent) or func(error). The handler is called from its own
+// goroutine, one event at a time, until the returned subscription is cancelled.
+//
+// The events are buffered like with Subscribe, and opts may be nil for the defaults. With
+// OverflowBlock, a slow handler stalls the client once the buffer is full; choose another
+// policy if events may be dropped instead. A filter in opts is applied in addition to the type.
+//
+// This panics if handler is not a function with exactly one parameter.
+func (c *Client) On(handler interface{}, opts *SubscribeOptions) *Subscription {
+	fn := reflect.ValueOf(handler)
+	typ := fn.Type()
+	if typ.Kind() != reflect.Func || typ.NumIn() != 1 {
+		panic(fmt.Sprintf("steam: On requires a function with one parameter, got %v", typ))
+	}
+	eventType := typ.In(0)
+
+	o := SubscribeOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	filter := o.Filter
+	o.Filter = func(event interface{}) bool {
+		return event != nil && reflect.TypeOf(event).AssignableTo(eventType) && (filter == nil || filter(event))
+	}
+	s := c.Subscribe(&o)
+	go func() {
+		for {
+			select {
+			case <-s.done:
+				return
+			case event, ok := <-s.events:
+				if !ok {
+					return
+				}
+				fn.Call([]reflect.Value{reflect.ValueOf(event)})
+			}
+		}
+	}()
+	return s
+}
@@ -0,0 +1,93 @@
+package steam
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSubscriptionOverflow(t *testing.T) {
+	client := NewClient()
+	client.IgnoreEvents()
+	newest := client.Subscribe(&SubscribeOptions{Buffer: 2, Overflow: OverflowDropNewest})
+	oldest := client.Subscribe(&SubscribeOptions{Buffer: 2, Overflow: OverflowDropOldest})
+
+	// nobody reads Events(), so this must not block
+	for i := 0; i < 5; i++ {
+		client.Emit(i)
+	}
+
+	if n := newest.Dropped(); n != 3 {
+		t.Fatalf("Expected 3 dropped events, got %v", n)
+	}
+	if e := <-newest.Events(); e != 0 {
+		t.Fatalf("Expected to keep the first event, got %v", e)
+	}
+	if n := oldest.Dropped(); n != 3 {
+		t.Fatalf("Expected 3 dropped events, got %v", n)
+	}
+	if e := <-oldest.Events(); e != 3 {
+		t.Fatalf("Expected to keep the latest events, got %v", e)
+	}
+
+	oldest.Unsubscribe()
+	client.Emit(5)
+	if e := <-oldest.Events(); e != 4 {
+		t.Fatalf("Expected buffered event 4, got %v", e)
+	}
+	if _, ok := <-oldest.Events(); ok {
+		t.Fatal("Expected the channel to be closed after Unsubscribe")
+	}
+}
+
+func TestSubscriptionFilter(t *testing.T) {
+	client := NewClient()
+	client.IgnoreEvents()
+	sub := client.Subscribe(&SubscribeOptions{
+		Filter: func(event interface{}) bool {
+			_, ok := event.(*LoggedOnEvent)
+			return ok
+		},
+	})
+	defer sub.Unsubscribe()
+
+	client.Emit(&ConnectedEvent{})
+	client.Emit(&LoggedOnEvent{})
+	if _, ok := (<-sub.Events()).(*LoggedOnEvent); !ok {
+		t.Fatal("Expected only a LoggedOnEvent")
+	}
+}
+
+func TestOn(t *testing.T) {
+	client := NewClient()
+	client.IgnoreEvents()
+	loggedOn := make(chan *LoggedOnEvent, 1)
+	errs := make(chan error, 1)
+	defer client.On(func(e *LoggedOnEvent) {
+		loggedOn <- e
+	}, nil).Unsubscribe()
+	defer client.On(func(err error) {
+		errs <- err
+	}, nil).Unsubscribe()
+
+	client.Emit(&ConnectedEvent{})
+	client.Emit(errors.New("test"))
+	client.Emit(&LoggedOnEvent{ServerTime: 42})
+
+	select {
+	case e := <-loggedOn:
+		if e.ServerTime != 42 {
+			t.Fatalf("Expected server time 42, got %v", e.ServerTime)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the LoggedOnEvent handler")
+	}
+	select {
+	case err := <-errs:
+		if err.Error() != "test" {
+			t.Fatalf("Unexpected error %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the error handler")
+	}
+}
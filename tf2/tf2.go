@@ -35,7 +35,8 @@ func (t *TF2) SetPlaying(playing bool) {
 
 func (t *TF2) SetItemPosition(itemId, position uint64) {
 	t.client.GC.Write(gamecoordinator.NewGCMsg(AppId, uint32(protobuf.EGCItemMsg_k_EMsgGCSetSingleItemPosition), &protocol.MsgGCSetItemPosition{
-		itemId, position,
+		AssetId:  itemId,
+		Position: position,
 	}))
 }
 
@@ -48,12 +49,14 @@ func (t *TF2) CraftItems(items []uint64, recipe int16) {
 }
 
 func (t *TF2) DeleteItem(itemId uint64) {
-	t.client.GC.Write(gamecoordinator.NewGCMsg(AppId, uint32(protobuf.EGCItemMsg_k_EMsgGCDelete), &protocol.MsgGCDeleteItem{itemId}))
+	t.client.GC.Write(gamecoordinator.NewGCMsg(AppId, uint32(protobuf.EGCItemMsg_k_EMsgGCDelete), &protocol.MsgGCDeleteItem{ItemId: itemId}))
 }
 
 func (t *TF2) NameItem(toolId, target uint64, name string) {
 	t.client.GC.Write(gamecoordinator.NewGCMsg(AppId, uint32(protobuf.EGCItemMsg_k_EMsgGCNameItem), &protocol.MsgGCNameItem{
-		toolId, target, name,
+		Tool:   toolId,
+		Target: target,
+		Name:   name,
 	}))
 }
 
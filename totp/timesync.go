@@ -0,0 +1,146 @@
+package totp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The length of the window a code is valid for.
+const CodePeriod = 30 * time.Second
+
+const queryTimeUrl = "https://api.steampowered.com/ITwoFactorService/QueryTime/v1/"
+
+// TimeSync keeps the offset between the local clock and Steam's clock, so that codes are
+// generated for Steam's time on machines whose clock is off. It is safe for concurrent use.
+type TimeSync struct {
+	// HTTPClient is used by Sync. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	url string
+
+	mutex  sync.RWMutex // guarding offset and synced
+	offset time.Duration
+	synced bool
+}
+
+// NewTimeSync creates a TimeSync that uses the local clock until it is synced.
+func NewTimeSync() *TimeSync {
+	return &TimeSync{url: queryTimeUrl}
+}
+
+// Sync queries Steam's time from ITwoFactorService/QueryTime and caches the offset to the local clock.
+func (s *TimeSync) Sync(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url, strings.NewReader("steamid=0"))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	sent := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("totp: QueryTime returned status code %d", resp.StatusCode)
+	}
+	result := new(struct {
+		Response struct {
+			ServerTime string `json:"server_time"`
+		}
+	})
+	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return err
+	}
+	serverTime, err := strconv.ParseInt(result.Response.ServerTime, 10, 64)
+	if err != nil {
+		return fmt.Errorf("totp: invalid server time %q", result.Response.ServerTime)
+	}
+	// the server time was taken about halfway through the request
+	local := sent.Add(time.Since(sent) / 2)
+	s.setOffset(time.Unix(serverTime, 0).Sub(local))
+	return nil
+}
+
+// SetServerTime caches the offset to a server time that was received just now,
+// for example steam.LoggedOnEvent.ServerTime, instead of querying it with Sync.
+func (s *TimeSync) SetServerTime(serverTime time.Time) {
+	s.setOffset(serverTime.Sub(time.Now()))
+}
+
+func (s *TimeSync) setOffset(offset time.Duration) {
+	s.mutex.Lock()
+	s.offset = offset.Round(time.Second)
+	s.synced = true
+	s.mutex.Unlock()
+}
+
+// Synced returns true once the offset has been set by Sync or SetServerTime.
+func (s *TimeSync) Synced() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.synced
+}
+
+// Offset returns the difference between Steam's clock and the local clock.
+func (s *TimeSync) Offset() time.Duration {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.offset
+}
+
+// Now returns Steam's current time.
+func (s *TimeSync) Now() time.Time {
+	return time.Now().Add(s.Offset())
+}
+
+// GenerateCode generates the code for Steam's current time.
+func (s *TimeSync) GenerateCode(sharedSecret string) (string, error) {
+	return GenerateTotpCode(sharedSecret, s.Now())
+}
+
+// WaitForFreshCode waits for the next code window if the current one ends in less than minValid,
+// so that a code generated afterwards is still valid when it reaches Steam.
+func (s *TimeSync) WaitForFreshCode(ctx context.Context, minValid time.Duration) error {
+	return waitForFreshCode(ctx, s.Now(), minValid)
+}
+
+// NewSyncedTotp creates new Totp structure with Steam's current time.
+func NewSyncedTotp(sharedSecret string, sync *TimeSync) *Totp {
+	return &Totp{sharedSecret, sync.Now()}
+}
+
+// CodeTimeLeft returns how long the code for the given time stays valid.
+func CodeTimeLeft(t time.Time) time.Duration {
+	return CodePeriod - time.Duration(t.UnixNano()%int64(CodePeriod))
+}
+
+// WaitForFreshCode waits for the next code window of the local clock if the current one ends in less than minValid.
+func WaitForFreshCode(ctx context.Context, minValid time.Duration) error {
+	return waitForFreshCode(ctx, time.Now(), minValid)
+}
+
+func waitForFreshCode(ctx context.Context, now time.Time, minValid time.Duration) error {
+	left := CodeTimeLeft(now)
+	if left >= minValid {
+		return nil
+	}
+	timer := time.NewTimer(left)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
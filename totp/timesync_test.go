@@ -0,0 +1,46 @@
+package totp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTimeSync(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("unexpected method %v", r.Method)
+		}
+		fmt.Fprintf(w, `{"response":{"server_time":"%d","skew_tolerance_seconds":"60"}}`, time.Now().Add(100*time.Second).Unix())
+	}))
+	defer server.Close()
+
+	sync := NewTimeSync()
+	sync.url = server.URL
+	if sync.Synced() || sync.Offset() != 0 {
+		t.Fatal("expected a new TimeSync to use the local clock")
+	}
+	if err := sync.Sync(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if offset := sync.Offset(); !sync.Synced() || offset < 99*time.Second || offset > 101*time.Second {
+		t.Fatalf("expected an offset of about 100s, got %v", offset)
+	}
+
+	sync.SetServerTime(time.Now().Add(-time.Hour))
+	if offset := sync.Offset(); offset != -time.Hour {
+		t.Fatalf("expected an offset of -1h, got %v", offset)
+	}
+}
+
+func TestCodeTimeLeft(t *testing.T) {
+	if left := CodeTimeLeft(time.Unix(1685025431, 0)); left != 19*time.Second {
+		t.Fatalf("expected 19s, got %v", left)
+	}
+	if left := CodeTimeLeft(time.Unix(1685025420, 0)); left != CodePeriod {
+		t.Fatalf("expected 30s at the start of a window, got %v", left)
+	}
+}
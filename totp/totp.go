@@ -12,6 +12,9 @@ import (
 // ErrInvalidSharedSecret is returned when shared secret isn't in base64 form
 var ErrInvalidSharedSecret error = errors.New("invalid base64 shared secret")
 
+// ErrInvalidIdentitySecret is returned when identity secret isn't in base64 form
+var ErrInvalidIdentitySecret error = errors.New("invalid base64 identity secret")
+
 const (
 	// Range of possible chars for auth code.
 	chars    string = "23456789BCDFGHJKMNPQRTVWXY"
@@ -83,3 +86,23 @@ func GenerateTotpCode(sharedSecret string, time time.Time) (string, error) {
 
 	return string(code[:]), nil
 }
+
+// GenerateConfirmationKey generates the key that authorizes a mobile confirmation request, see the confirmation package.
+// The tag names the action, for example "conf", "details", "allow" or "cancel"; only its first 32 bytes are used.
+func GenerateConfirmationKey(identitySecret string, time time.Time, tag string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(identitySecret)
+	if err != nil {
+		return "", ErrInvalidIdentitySecret
+	}
+	if len(tag) > 32 {
+		tag = tag[:32]
+	}
+
+	buf := make([]byte, 8, 8+len(tag))
+	binary.BigEndian.PutUint64(buf, uint64(time.Unix()))
+	buf = append(buf, tag...)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(buf)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
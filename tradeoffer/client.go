@@ -25,8 +25,16 @@ type Client struct {
 }
 
 func NewClient(key APIKey, sessionId, steamLogin, steamLoginSecure string) *Client {
+	return NewClientWithHTTPClient(new(http.Client), key, sessionId, steamLogin, steamLoginSecure)
+}
+
+// Creates a client that makes its requests with a copy of the given HTTP client, for example
+// one that goes through a proxy. The cookies are set on the copy, so the client can be shared.
+func NewClientWithHTTPClient(httpClient *http.Client, key APIKey, sessionId, steamLogin, steamLoginSecure string) *Client {
+	client := *httpClient
+	client.Jar = nil
 	c := &Client{
-		new(http.Client),
+		&client,
 		key,
 		sessionId,
 	}
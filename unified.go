@@ -0,0 +1,115 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Provides access to the unified service methods such as Player.GetOwnedGames#1.
+//
+// Methods are called by their target job name, which is the name of the service and
+// the method followed by the version, and use the messages in protocol/protobuf/unified.
+type Unified struct {
+	client *Client
+
+	mutex         sync.RWMutex // guarding notifications
+	notifications map[string]protoreflect.MessageType
+}
+
+func newUnified(client *Client) *Unified {
+	return &Unified{
+		client:        client,
+		notifications: make(map[string]protoreflect.MessageType),
+	}
+}
+
+// Calls a service method, for example "Player.GetOwnedGames#1", and decodes the
+// response into resp. If Steam answers with a result other than EResult_OK,
+// an *EResultError is returned.
+//
+// Before logging on, the call is sent as a non-authenticated call, which Steam only
+// accepts for a few services such as Authentication.
+func (u *Unified) Call(ctx context.Context, method string, req, resp proto.Message) error {
+	eMsg := steamlang.EMsg_ServiceMethodCallFromClient
+	if u.client.SessionId() == 0 {
+		eMsg = steamlang.EMsg_ServiceMethodCallFromClientNonAuthed
+	}
+	msg := protocol.NewClientMsgProtobuf(eMsg, req)
+	msg.Header.Proto.TargetJobName = proto.String(method)
+
+	packet, err := u.client.Call(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	header := steamlang.NewMsgHdrProtoBuf()
+	buf := bytes.NewBuffer(packet.Data)
+	if err := header.Deserialize(buf); err != nil {
+		return err
+	}
+	if result := steamlang.EResult(header.Proto.GetEresult()); result != steamlang.EResult_OK {
+		return &EResultError{
+			Op:      method,
+			Result:  result,
+			Message: header.Proto.GetErrorMessage(),
+		}
+	}
+	if resp == nil {
+		return nil
+	}
+	return proto.Unmarshal(buf.Bytes(), resp)
+}
+
+// Registers the message type that the body of the given notification, for example
+// "ChatRoomClient.NotifyIncomingChatMessage#1", is decoded into.
+// Notifications that are not registered are emitted with a nil body.
+func (u *Unified) RegisterNotification(method string, prototype proto.Message) {
+	u.mutex.Lock()
+	u.notifications[method] = prototype.ProtoReflect().Type()
+	u.mutex.Unlock()
+}
+
+func (u *Unified) HandlePacket(packet *protocol.Packet) {
+	switch packet.EMsg {
+	case steamlang.EMsg_ServiceMethod, steamlang.EMsg_ServiceMethodSendToClient:
+		u.handleNotification(packet)
+	}
+}
+
+func (u *Unified) handleNotification(packet *protocol.Packet) {
+	if !packet.IsProto {
+		return
+	}
+	header := steamlang.NewMsgHdrProtoBuf()
+	buf := bytes.NewBuffer(packet.Data)
+	if err := header.Deserialize(buf); err != nil {
+		u.client.Errorf("unified: error reading notification header: %v", err)
+		return
+	}
+	method := header.Proto.GetTargetJobName()
+
+	u.mutex.RLock()
+	typ, ok := u.notifications[method]
+	u.mutex.RUnlock()
+
+	var body proto.Message
+	if ok {
+		body = typ.New().Interface()
+		if err := proto.Unmarshal(buf.Bytes(), body); err != nil {
+			u.client.Errorf("unified: error decoding %s: %v", method, err)
+			return
+		}
+	}
+
+	u.client.Emit(&ServiceNotificationEvent{
+		Method: method,
+		Body:   body,
+		Packet: packet,
+	})
+}
@@ -0,0 +1,16 @@
+package steam
+
+import (
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// Emitted when Steam pushes a unified service notification to the client.
+type ServiceNotificationEvent struct {
+	// The target job name, for example "ChatRoomClient.NotifyIncomingChatMessage#1".
+	Method string
+	// The decoded body if the method was registered with Unified.RegisterNotification, otherwise nil.
+	Body proto.Message
+	// The raw packet, so that unregistered notifications can still be decoded.
+	Packet *protocol.Packet
+}
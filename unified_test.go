@@ -0,0 +1,109 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf/unified"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUnifiedCall(t *testing.T) {
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		for _, result := range []steamlang.EResult{steamlang.EResult_OK, steamlang.EResult_AccessDenied} {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			packet, err := protocol.NewPacket(data)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			req := new(unified.CPlayer_GetOwnedGames_Request)
+			msg := packet.ReadProtoMsg(req)
+			if packet.EMsg != steamlang.EMsg_ServiceMethodCallFromClient {
+				t.Errorf("Expected EMsg_ServiceMethodCallFromClient, got %v", packet.EMsg)
+			}
+			if name := msg.Header.Proto.GetTargetJobName(); name != "Player.GetOwnedGames#1" {
+				t.Errorf("Expected target job name Player.GetOwnedGames#1, got %v", name)
+			}
+
+			resp := protocol.NewClientMsgProtobuf(steamlang.EMsg_ServiceMethodResponse, &unified.CPlayer_GetOwnedGames_Response{
+				GameCount: proto.Uint32(uint32(req.GetSteamid())),
+			})
+			resp.SetTargetJobId(packet.SourceJobId)
+			resp.Header.Proto.Eresult = proto.Int32(int32(result))
+			buf := new(bytes.Buffer)
+			resp.Serialize(buf)
+			conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+		}
+		conn.ReadMessage()
+	})
+
+	client := NewClient()
+	if err := client.ConnectToWebSocket(url); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect()
+	// pretend to be logged on
+	atomic.StoreInt32(&client.sessionId, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := &unified.CPlayer_GetOwnedGames_Request{Steamid: proto.Uint64(42)}
+	resp := new(unified.CPlayer_GetOwnedGames_Response)
+	if err := client.Unified.Call(ctx, "Player.GetOwnedGames#1", req, resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetGameCount() != 42 {
+		t.Fatalf("Expected a game count of 42, got %v", resp.GetGameCount())
+	}
+
+	err := client.Unified.Call(ctx, "Player.GetOwnedGames#1", req, resp)
+	var resultErr *EResultError
+	if !errors.As(err, &resultErr) || resultErr.Result != steamlang.EResult_AccessDenied {
+		t.Fatalf("Expected an EResultError with EResult_AccessDenied, got %v", err)
+	}
+}
+
+func TestUnifiedNotification(t *testing.T) {
+	client := NewClient()
+	client.Unified.RegisterNotification("Player.GetOwnedGames#1", new(unified.CPlayer_GetOwnedGames_Response))
+
+	msg := protocol.NewClientMsgProtobuf(steamlang.EMsg_ServiceMethod, &unified.CPlayer_GetOwnedGames_Response{
+		GameCount: proto.Uint32(3),
+	})
+	msg.Header.Proto.TargetJobName = proto.String("Player.GetOwnedGames#1")
+	buf := new(bytes.Buffer)
+	if err := msg.Serialize(buf); err != nil {
+		t.Fatal(err)
+	}
+	packet, err := protocol.NewPacket(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.IgnoreEvents()
+	sub := client.Subscribe(nil)
+	defer sub.Unsubscribe()
+	client.Unified.HandlePacket(packet)
+
+	event, ok := (<-sub.Events()).(*ServiceNotificationEvent)
+	if !ok {
+		t.Fatal("Expected a ServiceNotificationEvent")
+	}
+	body, ok := event.Body.(*unified.CPlayer_GetOwnedGames_Response)
+	if !ok || body.GetGameCount() != 3 {
+		t.Fatalf("Expected a decoded body with a game count of 3, got %v", event.Body)
+	}
+}
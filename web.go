@@ -48,6 +48,12 @@ func (w *Web) HandlePacket(packet *protocol.Packet) {
 	}
 }
 
+// Returns the HTTP client that is used for web requests, see Client.HTTPClient.
+// Pass it to tradeoffer.NewClientWithHTTPClient and the like to use the same proxy.
+func (w *Web) HTTPClient() *http.Client {
+	return w.client.httpClient()
+}
+
 // Fetches the `steamLogin` cookie. This may only be called after the first
 // WebSessionIdEvent or it will panic.
 func (w *Web) LogOn() {
@@ -65,6 +71,7 @@ func (w *Web) LogOn() {
 			}
 		}
 		if err != nil {
+			w.client.log(LogWeb).Error("Web logon failed", "error", err)
 			w.client.Emit(WebLogOnErrorEvent(err))
 			return
 		}
@@ -75,7 +82,11 @@ func (w *Web) apiLogOn() error {
 	sessionKey := make([]byte, 32)
 	rand.Read(sessionKey)
 
-	cryptedSessionKey := cryptoutil.RSAEncrypt(GetPublicKey(steamlang.EUniverse_Public), sessionKey)
+	key := GetPublicKey(w.client.universe())
+	if key == nil {
+		return errors.New("steam.Web.apiLogOn: no public key for universe " + w.client.universe().String())
+	}
+	cryptedSessionKey := cryptoutil.RSAEncrypt(key, sessionKey)
 	ciph, _ := aes.NewCipher(sessionKey)
 	cryptedLoginKey := cryptoutil.SymmetricEncrypt(ciph, []byte(w.webLoginKey))
 	data := make(url.Values)
@@ -83,7 +94,8 @@ func (w *Web) apiLogOn() error {
 	data.Add("steamid", strconv.FormatUint(w.client.SteamId().ToUint64(), 10))
 	data.Add("sessionkey", string(cryptedSessionKey))
 	data.Add("encrypted_loginkey", string(cryptedLoginKey))
-	resp, err := http.PostForm("https://api.steampowered.com/ISteamUserAuth/AuthenticateUser/v0001", data)
+	w.client.log(LogWeb).Debug("Authenticating with the Web API", "steamId", w.client.SteamId())
+	resp, err := w.HTTPClient().PostForm("https://api.steampowered.com/ISteamUserAuth/AuthenticateUser/v0001", data)
 	if err != nil {
 		return err
 	}
@@ -91,6 +103,7 @@ func (w *Web) apiLogOn() error {
 
 	if resp.StatusCode == 401 {
 		// our web login key has expired, request a new one
+		w.client.log(LogWeb).Info("Web login key expired, requesting a new one")
 		atomic.StoreUint32(&w.relogOnNonce, 1)
 		w.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientRequestWebAPIAuthenticateUserNonce, new(protobuf.CMsgClientRequestWebAPIAuthenticateUserNonce)))
 		return nil
@@ -111,6 +124,7 @@ func (w *Web) apiLogOn() error {
 
 	w.SteamLogin = result.Authenticateuser.Token
 	w.SteamLoginSecure = result.Authenticateuser.TokenSecure
+	w.client.log(LogWeb).Info("Logged on to the Web API")
 
 	w.client.Emit(new(WebLoggedOnEvent))
 	return nil
@@ -126,6 +140,7 @@ func (w *Web) handleNewLoginKey(packet *protocol.Packet) {
 
 	// number -> string -> bytes -> base64
 	w.SessionId = base64.StdEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(msg.GetUniqueId()), 10)))
+	w.client.log(LogWeb).Debug("Received web session id")
 
 	w.client.Emit(new(WebSessionIdEvent))
 }
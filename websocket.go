@@ -0,0 +1,83 @@
+package steam
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/gorilla/websocket"
+)
+
+// A connection to a WebSocket CM server.
+//
+// Every message is sent as a single binary WebSocket frame. Unlike tcpConnection,
+// there is no VT01 framing and no channel encryption; the stream is protected by TLS instead.
+type websocketConnection struct {
+	conn       *websocket.Conn
+	writeMutex sync.Mutex
+}
+
+// Returns the WebSocket URL for a CM server. The endpoint can either be a host with an optional
+// port as returned by the Steam Directory, or a complete ws:// or wss:// URL.
+func websocketURL(endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	return "wss://" + endpoint + "/cmsocket/"
+}
+
+func dialWebSocket(ctx context.Context, dialer *websocket.Dialer, endpoint string) (*websocketConnection, error) {
+	if dialer == nil {
+		dialer = websocket.DefaultDialer
+	}
+	conn, _, err := dialer.DialContext(ctx, websocketURL(endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &websocketConnection{
+		conn: conn,
+	}, nil
+}
+
+func (c *websocketConnection) Read() (*protocol.Packet, error) {
+	for {
+		typ, buf, err := c.conn.ReadMessage()
+		if err != nil {
+			return nil, err
+		}
+		switch typ {
+		case websocket.BinaryMessage:
+			return protocol.NewPacket(buf)
+		case websocket.TextMessage:
+			return nil, errors.New("Unexpected text message on WebSocket connection")
+		}
+		// control frames are handled by the websocket package itself
+	}
+}
+
+func (c *websocketConnection) Write(message []byte) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	return c.conn.WriteMessage(websocket.BinaryMessage, message)
+}
+
+func (c *websocketConnection) Close() error {
+	c.writeMutex.Lock()
+	// tell the server that we're going away, but don't wait for its answer
+	c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	c.writeMutex.Unlock()
+	return c.conn.Close()
+}
+
+// WebSocket connections are encrypted by TLS, so the channel encryption key is never used.
+func (c *websocketConnection) SetEncryptionKey(key, hmacSecret []byte) {
+	if key != nil {
+		panic("Channel encryption is not supported on WebSocket connections!")
+	}
+}
+
+func (c *websocketConnection) IsEncrypted() bool {
+	return true
+}
@@ -0,0 +1,153 @@
+package steam
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Philipp15b/go-steam/v3/protocol"
+	"github.com/Philipp15b/go-steam/v3/protocol/protobuf"
+	"github.com/Philipp15b/go-steam/v3/protocol/steamlang"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWebSocketConnection(t *testing.T) {
+	received := make(chan *protocol.Packet, 1)
+	url := newWebSocketStandIn(t, func(conn *websocket.Conn) {
+		typ, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if typ != websocket.BinaryMessage {
+			t.Errorf("Expected a binary message, got type %v", typ)
+		}
+		packet, err := protocol.NewPacket(data)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		received <- packet
+
+		buf := new(bytes.Buffer)
+		protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientAccountInfo, &protobuf.CMsgClientAccountInfo{
+			PersonaName: proto.String("gopher"),
+		}).Serialize(buf)
+		if err = conn.WriteMessage(websocket.BinaryMessage, buf.Bytes()); err != nil {
+			t.Error(err)
+			return
+		}
+
+		// wait for the client to go away
+		conn.ReadMessage()
+	})
+
+	client := NewClient()
+	err := client.ConnectToWebSocket(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Disconnect()
+
+	if _, ok := nextEvent(t, client).(*ConnectedEvent); !ok {
+		t.Fatal("Expected a ConnectedEvent")
+	}
+
+	client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientHeartBeat, new(protobuf.CMsgClientHeartBeat)))
+	select {
+	case packet := <-received:
+		if packet.EMsg != steamlang.EMsg_ClientHeartBeat {
+			t.Fatalf("Expected EMsg_ClientHeartBeat, got %v", packet.EMsg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the server to receive a message")
+	}
+
+	info, ok := nextEvent(t, client).(*AccountInfoEvent)
+	if !ok {
+		t.Fatal("Expected an AccountInfoEvent")
+	}
+	if info.PersonaName != "gopher" {
+		t.Fatalf("Expected persona name gopher, got %v", info.PersonaName)
+	}
+}
+
+func TestWebSocketReconnectContext(t *testing.T) {
+	// a server that accepts connections but never answers the handshake
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	client := NewClient()
+	client.IgnoreEvents()
+	client.setLastServer(lastServer{addr: "ws://" + l.Addr().String() + "/cmsocket/", webSocket: true})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- client.reconnectToLastServer(ctx)
+	}()
+	select {
+	case err = <-done:
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("The WebSocket handshake ignored the context")
+	}
+}
+
+// Starts a local WebSocket CM stand-in that calls handler for every connection
+// and returns its URL. The server is closed when the test finishes.
+func newWebSocketStandIn(t *testing.T, handler func(conn *websocket.Conn)) string {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cmsocket/" {
+			http.NotFound(w, r)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		handler(conn)
+	}))
+	t.Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/cmsocket/"
+}
+
+func nextEvent(t *testing.T, client *Client) interface{} {
+	select {
+	case event := <-client.Events():
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for an event")
+		return nil
+	}
+}
+
+func TestNoWebSocketServers(t *testing.T) {
+	sd := &steamDirectory{isInitialized: true, servers: []string{"127.0.0.1:27017"}}
+	if _, err := sd.GetRandomWebSocketCM(); err == nil {
+		t.Fatal("Expected an error for a directory without WebSocket servers")
+	}
+}